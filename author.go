@@ -12,15 +12,25 @@ type getOneAuthorResponse struct {
 }
 
 type getAuthorBooks struct {
-	AuthorWithBooks AuthorWithBooks `xml:"author"`
+	Author authorWithBookList `xml:"author"`
+}
+
+// authorWithBookList is decoded in place of AuthorWithBooks to get the pagination
+// of the <books> element, encoding/xml can't map both the element and its children
+type authorWithBookList struct {
+	Author
+	BookList struct {
+		Pagination
+		Books []Book `xml:"book"`
+	} `xml:"books"`
 }
 
 // GetOneAuthor will returns the details of the given author ID
-func (c client) GetOneAuthor(ctx context.Context, authorID int) (Author, error) {
+func (c client) GetOneAuthor(ctx context.Context, authorID Int) (Author, error) {
 	var response = getOneAuthorResponse{}
 
 	q := url.Values{}
-	q.Set("id", strconv.Itoa(authorID))
+	q.Set("id", strconv.Itoa(int(authorID)))
 
 	err := c.Get(ctx, fmt.Sprintf("/author/show"), q, &response)
 
@@ -33,18 +43,14 @@ func (c client) GetOneAuthor(ctx context.Context, authorID int) (Author, error)
 
 // GetAuthorBooks returns some details of the author and a paginated list of his books
 // For pagination 0 or 1 seems to be the same thing.
-// The pagination will paginate the works if there's more than 100~
-func (c client) GetAuthorBooks(ctx context.Context, authorID int, page int) (AuthorWithBooks, error) {
-	var response = getAuthorBooks{
-		AuthorWithBooks{
-			Author: Author{},
-			Books:  []Book{},
-		},
-	}
+// The pagination will paginate the works if there's more than 100~, see AllAuthorBooks to get all the pages
+func (c client) GetAuthorBooks(ctx context.Context, authorID Int, page int) (AuthorWithBooks, error) {
+	var response = getAuthorBooks{}
+	response.Author.BookList.Books = []Book{}
 
 	q := url.Values{}
 	q.Set("page", strconv.Itoa(page))
-	q.Set("id", strconv.Itoa(authorID))
+	q.Set("id", strconv.Itoa(int(authorID)))
 
 	err := c.Get(ctx, fmt.Sprintf("/author/list"), q, &response)
 
@@ -52,5 +58,37 @@ func (c client) GetAuthorBooks(ctx context.Context, authorID int, page int) (Aut
 		return AuthorWithBooks{}, fmt.Errorf("failed to get the books for the author #%d in page #%d: %w", authorID, page, err)
 	}
 
-	return response.AuthorWithBooks, nil
+	return AuthorWithBooks{
+		Author:     response.Author.Author,
+		Books:      response.Author.BookList.Books,
+		Pagination: response.Author.BookList.Pagination,
+	}, nil
+}
+
+// AllAuthorBooks returns the author with the books of all the pages
+// It stops after maxPages pages with the books fetched so far and an error wrapping ErrTooManyPages
+func (c client) AllAuthorBooks(ctx context.Context, authorID Int) (AuthorWithBooks, error) {
+	var all = AuthorWithBooks{
+		Books: []Book{},
+	}
+
+	for page := 1; ; page++ {
+		if page > maxPages {
+			return all, fmt.Errorf("failed to get all the books for the author #%d: %w", authorID, ErrTooManyPages)
+		}
+
+		author, err := c.GetAuthorBooks(ctx, authorID, page)
+
+		if err != nil {
+			return AuthorWithBooks{}, err
+		}
+
+		all.Author = author.Author
+		all.Books = append(all.Books, author.Books...)
+		all.Pagination = Pagination{Start: 1, End: Int(len(all.Books)), Total: author.Pagination.Total}
+
+		if len(author.Books) == 0 || !author.Pagination.HasNextPage() {
+			return all, nil
+		}
+	}
 }
@@ -0,0 +1,52 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type authorFollowingResponse struct {
+	AuthorFollowing AuthorFollowing `xml:"author_following"`
+}
+
+// FollowAuthor makes the authenticated user follow the author
+func (c client) FollowAuthor(ctx context.Context, authorID Int) (AuthorFollowing, error) {
+	var response = authorFollowingResponse{}
+
+	form := url.Values{}
+	form.Set("id", strconv.Itoa(int(authorID)))
+
+	err := c.Post(ctx, "/author_followings", form, &response)
+
+	if err != nil {
+		return AuthorFollowing{}, fmt.Errorf("failed to follow the author #%d: %w", authorID, err)
+	}
+
+	return response.AuthorFollowing, nil
+}
+
+// UnfollowAuthor stops following an author, it takes the id of the following not the author one
+func (c client) UnfollowAuthor(ctx context.Context, followingID Int) error {
+	err := c.Delete(ctx, fmt.Sprintf("/author_followings/%d", followingID), url.Values{}, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete the author following #%d: %w", followingID, err)
+	}
+
+	return nil
+}
+
+// GetAuthorFollowing retrieve a specific author following
+func (c client) GetAuthorFollowing(ctx context.Context, followingID Int) (AuthorFollowing, error) {
+	var response = authorFollowingResponse{}
+
+	err := c.Get(ctx, fmt.Sprintf("/author_followings/%d", followingID), url.Values{}, &response)
+
+	if err != nil {
+		return AuthorFollowing{}, fmt.Errorf("failed to get the author following #%d: %w", followingID, err)
+	}
+
+	return response.AuthorFollowing, nil
+}
@@ -0,0 +1,154 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var eamesFollowing = AuthorFollowing{
+	ID:        7724106,
+	CreatedAt: "2020-05-12T08:32:10-07:00",
+	UpdatedAt: "2020-05-12T08:32:10-07:00",
+	Author: Author{
+		ID:   15388346,
+		Name: "Nicholas Eames",
+	},
+	User: User{
+		ID:   1,
+		Name: "Otis Chandler",
+	},
+}
+
+func TestClient_FollowAuthor(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts the author and returns the following", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "//author_followings", r.URL.Path)
+			assert.Equal(t, "15388346", r.PostFormValue("id"))
+
+			content, _ := ioutil.ReadFile("fixtures/author_following.xml")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		following, err := client.FollowAuthor(ctx, 15388346)
+
+		assert.NoError(t, err)
+		assert.Equal(t, eamesFollowing, following)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		following, err := client.FollowAuthor(ctx, 15388346)
+
+		assert.EqualError(t, err, "failed to follow the author #15388346: request failed for '//author_followings': 401 Unauthorized")
+		assert.Equal(t, AuthorFollowing{}, following)
+	})
+}
+
+func TestClient_UnfollowAuthor(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("deletes the following", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			assert.Equal(t, "//author_followings/7724106", r.URL.Path)
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		assert.NoError(t, client.UnfollowAuthor(ctx, 7724106))
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.UnfollowAuthor(ctx, 7724106)
+
+		assert.EqualError(t, err, "failed to delete the author following #7724106: request failed for '//author_followings/7724106': 404 Not Found")
+	})
+}
+
+func TestClient_GetAuthorFollowing(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the following", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//author_followings/7724106", r.URL.Path)
+
+			content, _ := ioutil.ReadFile("fixtures/author_following.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		following, err := client.GetAuthorFollowing(ctx, 7724106)
+
+		assert.NoError(t, err)
+		assert.Equal(t, eamesFollowing, following)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		following, err := client.GetAuthorFollowing(ctx, 7724106)
+
+		assert.EqualError(t, err, "failed to get the author following #7724106: request failed for '//author_followings/7724106': 500 Internal Server Error")
+		assert.Equal(t, AuthorFollowing{}, following)
+	})
+}
@@ -2,6 +2,7 @@ package goodreads
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -40,11 +41,29 @@ func TestClient_GetOneAuthor(t *testing.T) {
 			WorkCount:     8,
 			Gender:        "male",
 			Hometown:      "",
-			BornDate:      "",
-			DiedAt:        "",
+			BornDate:      PartialDate{},
+			DiedAt:        PartialDate{},
 		}, author)
 	})
 
+	t.Run("leaves the blank numbers to zero", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintln(w, `<GoodreadsResponse><author><id>15388346</id><works_count><![CDATA[ ]]></works_count></author></GoodreadsResponse>`)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		author, err := client.GetOneAuthor(ctx, 15388346)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Author{ID: 15388346}, author)
+	})
+
 	t.Run("returns error if the called failed", func(t *testing.T) {
 		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, "wtf", http.StatusInternalServerError)
@@ -94,8 +113,8 @@ func TestClient_GetAuthorBooks(t *testing.T) {
 				WorkCount:     0,
 				Gender:        "",
 				Hometown:      "",
-				BornDate:      "",
-				DiedAt:        "",
+				BornDate:      PartialDate{},
+				DiedAt:        PartialDate{},
 			},
 			Books: []Book{
 				{
@@ -108,6 +127,12 @@ func TestClient_GetAuthorBooks(t *testing.T) {
 					Format:             "Paperback",
 					EditionInformation: "",
 					Publisher:          "Orbit",
+					ISBN:               "0316362476",
+					ISBN13:             "9780316362474",
+					AverageRating:      4.32,
+					RatingsCount:       20119,
+					TextReviewsCount:   2640,
+					Link:               "https://www.goodreads.com/book/show/30841984-kings-of-the-wyld",
 					Work: Work{
 						WorkID: 51246585,
 					},
@@ -122,11 +147,11 @@ func TestClient_GetAuthorBooks(t *testing.T) {
 							WorkCount:     0,
 							Gender:        "",
 							Hometown:      "",
-							BornDate:      "",
-							DiedAt:        "",
+							BornDate:      PartialDate{},
+							DiedAt:        PartialDate{},
 						},
 					},
-					PublicationDate: PublicationDate{
+					PublicationDate: PartialDate{
 						Year:  2017,
 						Month: 2,
 						Day:   21,
@@ -142,6 +167,12 @@ func TestClient_GetAuthorBooks(t *testing.T) {
 					Format:             "Paperback",
 					EditionInformation: "",
 					Publisher:          "Orbit",
+					ISBN:               "0356509044",
+					ISBN13:             "9780356509044",
+					AverageRating:      4.25,
+					RatingsCount:       7835,
+					TextReviewsCount:   956,
+					Link:               "https://www.goodreads.com/book/show/35052265-bloody-rose",
 					Work: Work{
 						WorkID: 56340013,
 					},
@@ -156,17 +187,18 @@ func TestClient_GetAuthorBooks(t *testing.T) {
 							WorkCount:     0,
 							Gender:        "",
 							Hometown:      "",
-							BornDate:      "",
-							DiedAt:        "",
+							BornDate:      PartialDate{},
+							DiedAt:        PartialDate{},
 						},
 					},
-					PublicationDate: PublicationDate{
+					PublicationDate: PartialDate{
 						Year:  2018,
 						Month: 8,
 						Day:   30,
 					},
 				},
 			},
+			Pagination: Pagination{Start: 1, End: 8, Total: 8},
 		}, author)
 	})
 
@@ -197,10 +229,11 @@ func TestClient_GetAuthorBooks(t *testing.T) {
 				WorkCount:     0,
 				Gender:        "",
 				Hometown:      "",
-				BornDate:      "",
-				DiedAt:        "",
+				BornDate:      PartialDate{},
+				DiedAt:        PartialDate{},
 			},
-			Books: []Book{},
+			Books:      []Book{},
+			Pagination: Pagination{Start: 0, End: 0, Total: 8},
 		}, author)
 	})
 
@@ -223,3 +256,92 @@ func TestClient_GetAuthorBooks(t *testing.T) {
 	})
 
 }
+
+func TestClient_AllAuthorBooks(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the books of all the pages", func(t *testing.T) {
+		requestedPages := []string{}
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "15388346", r.URL.Query().Get("id"))
+			requestedPages = append(requestedPages, r.URL.Query().Get("page"))
+
+			content, _ := ioutil.ReadFile(fmt.Sprintf("fixtures/get_author_books_page_%s.xml", r.URL.Query().Get("page")))
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		author, err := client.AllAuthorBooks(ctx, 15388346)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1", "2"}, requestedPages)
+		assert.Equal(t, AuthorWithBooks{
+			Author: Author{
+				ID:   15388346,
+				Name: "Nicholas Eames",
+			},
+			Books: []Book{
+				{ID: 30841984, Title: "Kings of the Wyld (The Band, #1)"},
+				{ID: 35052265, Title: "Bloody Rose (The Band, #2)"},
+				{ID: 51573640, Title: "The Band Box Set"},
+			},
+			Pagination: Pagination{Start: 1, End: 3, Total: 3},
+		}, author)
+	})
+
+	t.Run("stops after too many pages with the books fetched so far", func(t *testing.T) {
+		requests := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+
+			content, _ := ioutil.ReadFile("fixtures/get_author_books_page_1.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		author, err := client.AllAuthorBooks(ctx, 15388346)
+
+		assert.EqualError(t, err, "failed to get all the books for the author #15388346: stopped after 100 pages")
+		assert.True(t, errors.Is(err, ErrTooManyPages))
+		assert.Equal(t, "Nicholas Eames", author.Author.Name)
+		assert.Len(t, author.Books, 2*maxPages)
+		assert.Equal(t, Pagination{Start: 1, End: 2 * maxPages, Total: 3}, author.Pagination)
+		assert.Equal(t, maxPages, requests)
+	})
+
+	t.Run("returns an error if a page failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("page") == "2" {
+				http.Error(w, "wtf", http.StatusInternalServerError)
+				return
+			}
+
+			content, _ := ioutil.ReadFile("fixtures/get_author_books_page_1.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		author, err := client.AllAuthorBooks(ctx, 15388346)
+
+		assert.EqualError(t, err, "failed to get the books for the author #15388346 in page #2: request failed for '//author/list': 500 Internal Server Error")
+		assert.Equal(t, AuthorWithBooks{}, author)
+	})
+}
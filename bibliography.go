@@ -0,0 +1,164 @@
+package goodreads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Bibliography returns the works of the author from all the pages of its books
+// The editions are grouped by work, the canonical edition is the best book of the work
+// when Goodreads sends it, the most rated edition otherwise
+// The works are sorted by publication date, the ones without a date come last
+// When the author has more than maxPages pages of books it's built from the fetched ones and Truncated is set
+func (c client) Bibliography(ctx context.Context, authorID Int) (Bibliography, error) {
+	author, err := c.AllAuthorBooks(ctx, authorID)
+	truncated := errors.Is(err, ErrTooManyPages)
+
+	if err != nil && !truncated {
+		return Bibliography{}, fmt.Errorf("failed to get the bibliography of the author #%d: %w", authorID, err)
+	}
+
+	bibliography := Bibliography{
+		Author:     author.Author,
+		Works:      []BibliographyWork{},
+		CoAuthored: []BibliographyWork{},
+		Truncated:  truncated,
+	}
+
+	for _, work := range groupEditions(author.Books) {
+		work.CoAuthors = coAuthors(work.Book, authorID)
+
+		if len(work.CoAuthors) > 0 {
+			bibliography.CoAuthored = append(bibliography.CoAuthored, work)
+		} else {
+			bibliography.Works = append(bibliography.Works, work)
+		}
+	}
+
+	sortBibliographyWorks(bibliography.Works)
+	sortBibliographyWorks(bibliography.CoAuthored)
+
+	return bibliography, nil
+}
+
+// editionKey identifies the work of a book, the book itself when it has no work
+type editionKey struct {
+	workID Int
+	bookID Int
+}
+
+// groupEditions groups the books by work, in the order the works are first seen
+// A book seen twice (the pages shifted while they were fetched) is kept once
+// A book without a work is a work on its own
+func groupEditions(books []Book) []BibliographyWork {
+	works := []BibliographyWork{}
+	indexes := map[editionKey]int{}
+	seen := map[Int]bool{}
+
+	for _, book := range books {
+		if book.ID != 0 {
+			if seen[book.ID] {
+				continue
+			}
+
+			seen[book.ID] = true
+		}
+
+		key := editionKey{workID: book.Work.WorkID}
+
+		if key.workID == 0 {
+			key.bookID = book.ID
+		}
+
+		i, found := indexes[key]
+
+		if !found || key == (editionKey{}) {
+			i = len(works)
+			indexes[key] = i
+			works = append(works, BibliographyWork{WorkID: book.Work.WorkID})
+		}
+
+		works[i].Editions = append(works[i].Editions, book)
+	}
+
+	for i := range works {
+		works[i].Book = canonicalEdition(works[i].Editions)
+		works[i].PublicationDate = firstPublication(works[i].Editions)
+	}
+
+	return works
+}
+
+// canonicalEdition returns the best book of the work if it's one of the editions,
+// the most rated edition otherwise (the first one seen on a tie)
+func canonicalEdition(editions []Book) Book {
+	canonical := editions[0]
+
+	for _, edition := range editions {
+		if edition.Work.BestBookID != 0 && edition.ID == edition.Work.BestBookID {
+			return edition
+		}
+
+		if edition.RatingsCount > canonical.RatingsCount {
+			canonical = edition
+		}
+	}
+
+	return canonical
+}
+
+// firstPublication returns the original publication date of the work when Goodreads sends it,
+// the earliest publication date of the editions otherwise
+func firstPublication(editions []Book) PartialDate {
+	first := PartialDate{}
+
+	for _, edition := range editions {
+		if !edition.Work.OriginalPublicationDate.IsZero() {
+			return edition.Work.OriginalPublicationDate
+		}
+
+		if edition.PublicationDate.IsZero() {
+			continue
+		}
+
+		if first.IsZero() || edition.PublicationDate.Before(first) {
+			first = edition.PublicationDate
+		}
+	}
+
+	return first
+}
+
+// coAuthors returns the other authors of the book, the illustrators, translators,
+// editors... (any author with a role) don't count
+func coAuthors(book Book, authorID Int) []Author {
+	others := []Author{}
+
+	for _, author := range book.Authors {
+		if author.ID != authorID && strings.TrimSpace(author.Role) == "" {
+			others = append(others, author)
+		}
+	}
+
+	return others
+}
+
+// sortBibliographyWorks sorts the works by publication date then title, the works without a date come last
+func sortBibliographyWorks(works []BibliographyWork) {
+	sort.SliceStable(works, func(i, j int) bool {
+		a, b := works[i].PublicationDate, works[j].PublicationDate
+
+		if a.IsZero() != b.IsZero() {
+			return b.IsZero()
+		}
+
+		if cmp := a.Compare(b); cmp != 0 {
+			return cmp < 0
+		}
+
+		return works[i].Book.Title < works[j].Book.Title
+	})
+}
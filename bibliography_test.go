@@ -0,0 +1,164 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Bibliography(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("groups the editions by work and sorts the works", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//author/list", r.URL.Path)
+
+			content, _ := ioutil.ReadFile("fixtures/get_author_bibliography.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		bibliography, err := client.Bibliography(ctx, 15388346)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Nicholas Eames", bibliography.Author.Name)
+
+		type summary struct {
+			WorkID          Int
+			BookID          Int
+			Editions        []Int
+			PublicationDate string
+			CoAuthors       []string
+		}
+
+		summarize := func(works []BibliographyWork) []summary {
+			summaries := []summary{}
+
+			for _, work := range works {
+				s := summary{
+					WorkID:          work.WorkID,
+					BookID:          work.Book.ID,
+					Editions:        []Int{},
+					PublicationDate: work.PublicationDate.String(),
+					CoAuthors:       []string{},
+				}
+
+				for _, edition := range work.Editions {
+					s.Editions = append(s.Editions, edition.ID)
+				}
+
+				for _, author := range work.CoAuthors {
+					s.CoAuthors = append(s.CoAuthors, author.Name)
+				}
+
+				summaries = append(summaries, s)
+			}
+
+			return summaries
+		}
+
+		assert.Equal(t, []summary{
+			{WorkID: 0, BookID: 40000003, Editions: []Int{40000003}, PublicationDate: "2015", CoAuthors: []string{}},
+			{WorkID: 51246585, BookID: 31423196, Editions: []Int{30841984, 31423196}, PublicationDate: "2016", CoAuthors: []string{}},
+			{WorkID: 56340013, BookID: 35052265, Editions: []Int{35052265}, PublicationDate: "2018-08-28", CoAuthors: []string{}},
+			{WorkID: 60000002, BookID: 40000002, Editions: []Int{40000002}, PublicationDate: "", CoAuthors: []string{}},
+		}, summarize(bibliography.Works))
+
+		assert.Equal(t, []summary{
+			{WorkID: 60000001, BookID: 40000001, Editions: []Int{40000001}, PublicationDate: "2019-10", CoAuthors: []string{"Jane Writer"}},
+		}, summarize(bibliography.CoAuthored))
+	})
+
+	t.Run("returns an error if a page failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		bibliography, err := client.Bibliography(ctx, 15388346)
+
+		assert.EqualError(t, err, "failed to get the bibliography of the author #15388346: failed to get the books for the author #15388346 in page #1: request failed for '//author/list': 500 Internal Server Error")
+		assert.Equal(t, Bibliography{}, bibliography)
+	})
+
+	t.Run("keeps the fetched books after too many pages", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			content, _ := ioutil.ReadFile("fixtures/get_author_books_page_1.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		bibliography, err := client.Bibliography(ctx, 15388346)
+
+		assert.NoError(t, err)
+		assert.True(t, bibliography.Truncated)
+		assert.Equal(t, "Nicholas Eames", bibliography.Author.Name)
+		assert.Len(t, bibliography.Works, 2)
+	})
+}
+
+func TestGroupEditions(t *testing.T) {
+	t.Run("keeps once a book seen twice", func(t *testing.T) {
+		books := []Book{
+			{ID: 1, Work: Work{WorkID: 11}},
+			{ID: 2, Work: Work{WorkID: 11}},
+			{ID: 2, Work: Work{WorkID: 11}},
+			{ID: 3},
+			{ID: 3},
+		}
+
+		works := groupEditions(books)
+
+		assert.Len(t, works, 2)
+		assert.Equal(t, Int(11), works[0].WorkID)
+		assert.Len(t, works[0].Editions, 2)
+		assert.Equal(t, Int(0), works[1].WorkID)
+		assert.Len(t, works[1].Editions, 1)
+	})
+
+	t.Run("keeps apart the books without a work", func(t *testing.T) {
+		works := groupEditions([]Book{{ID: 1}, {ID: 2}, {}, {}})
+
+		assert.Len(t, works, 4)
+	})
+}
+
+func TestCanonicalEdition(t *testing.T) {
+	t.Run("prefers the best book of the work", func(t *testing.T) {
+		editions := []Book{
+			{ID: 1, RatingsCount: 100, Work: Work{BestBookID: 2}},
+			{ID: 2, RatingsCount: 10, Work: Work{BestBookID: 2}},
+		}
+
+		assert.Equal(t, Int(2), canonicalEdition(editions).ID)
+	})
+
+	t.Run("keeps the first edition on a tie", func(t *testing.T) {
+		editions := []Book{{ID: 1, RatingsCount: 10}, {ID: 2, RatingsCount: 10}}
+
+		assert.Equal(t, Int(1), canonicalEdition(editions).ID)
+	})
+}
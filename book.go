@@ -2,8 +2,10 @@ package goodreads
 
 import (
 	"context"
+	"encoding/xml"
 	"fmt"
 	"net/url"
+	"reflect"
 	"strconv"
 )
 
@@ -12,11 +14,11 @@ type getOneBook struct {
 }
 
 // GetOneBook retrieve a specific book
-func (c client) GetOneBook(ctx context.Context, bookID int) (Book, error) {
+func (c client) GetOneBook(ctx context.Context, bookID Int) (Book, error) {
 	var response = getOneBook{}
 
 	q := url.Values{}
-	q.Set("id", strconv.Itoa(bookID))
+	q.Set("id", strconv.Itoa(int(bookID)))
 
 	err := c.Get(ctx, fmt.Sprintf("/book/show"), q, &response)
 
@@ -26,3 +28,34 @@ func (c client) GetOneBook(ctx context.Context, bookID int) (Book, error) {
 
 	return response.Book, nil
 }
+
+// book has the same fields as Book without the custom unmarshaler
+type book Book
+
+// UnmarshalXML decodes the book, the publication date is split in 3 elements
+// A date with a part which is not a number is left to zero, strict mode reports it
+func (b *Book) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var raw struct {
+		book
+		PublicationYear  string `xml:"publication_year"`
+		PublicationMonth string `xml:"publication_month"`
+		PublicationDay   string `xml:"publication_day"`
+	}
+
+	if err := d.DecodeElement(&raw, &start); err != nil {
+		return err
+	}
+
+	*b = Book(raw.book)
+	b.PublicationDate, _ = newPartialDate(raw.PublicationYear, raw.PublicationMonth, raw.PublicationDay)
+
+	return nil
+}
+
+func (Book) handDecodedElements() map[string]reflect.Type {
+	return map[string]reflect.Type{
+		"publication_year":  reflect.TypeOf(Int(0)),
+		"publication_month": reflect.TypeOf(Int(0)),
+		"publication_day":   reflect.TypeOf(Int(0)),
+	}
+}
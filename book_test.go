@@ -30,6 +30,11 @@ func TestClient_GetOneBook(t *testing.T) {
 		book, err := client.GetOneBook(ctx, 1)
 
 		assert.NoError(t, err)
+
+		// checked in their own test, they are too long to be listed here
+		book.SimilarBooks = nil
+		book.PopularShelves = nil
+
 		assert.Equal(t, Book{
 			ID:                 862041,
 			Title:              "Harry Potter Series Box Set (Harry Potter, #1-7)",
@@ -40,16 +45,40 @@ func TestClient_GetOneBook(t *testing.T) {
 			Format:             "",
 			EditionInformation: "",
 			Publisher:          "Arthur A. Levine Books",
+			ISBN:               "0545044251",
+			ISBN13:             "9780545044257",
+			ASIN:               "",
+			KindleASIN:         "",
+			CountryCode:        "HK",
+			LanguageCode:       "eng",
+			IsEbook:            false,
+			AverageRating:      4.74,
+			RatingsCount:       215607,
+			TextReviewsCount:   6454,
+			URL:                "https://www.goodreads.com/book/show/862041.Harry_Potter_Series_Box_Set",
+			Link:               "https://www.goodreads.com/book/show/862041.Harry_Potter_Series_Box_Set",
 			Work: Work{
-				WorkID:        2962492,
-				BookID:        0,
-				BestBookID:    862041,
-				Title:         "",
-				OriginalTitle: "WHAAA",
-				ImageURL:      "",
-				SmallImageURL: "",
-				Author:        Author{},
-				OriginalPublicationDate: OriginalPublicationDate{
+				WorkID:                  2962492,
+				BookID:                  0,
+				BestBookID:              862041,
+				Title:                   "",
+				OriginalTitle:           "WHAAA",
+				ImageURL:                "",
+				SmallImageURL:           "",
+				Author:                  Author{},
+				BooksCount:              131,
+				ReviewsCount:            316458,
+				RatingsSum:              1137835,
+				RatingsCount:            239917,
+				TextReviewsCount:        7158,
+				MediaType:               "book",
+				OriginalLanguageID:      NullInt{Present: true},
+				DefaultChapteringBookID: NullInt{Present: true},
+				RatingDist: RatingDistribution{
+					Stars: [5]int{1510, 1605, 8013, 34869, 193920},
+					Total: 239917,
+				},
+				OriginalPublicationDate: PartialDate{
 					Year:  2007,
 					Month: 10,
 					Day:   1,
@@ -66,11 +95,43 @@ func TestClient_GetOneBook(t *testing.T) {
 					WorkCount:     0,
 					Gender:        "",
 					Hometown:      "",
-					BornDate:      "",
-					DiedAt:        "",
+					BornDate:      PartialDate{},
+					DiedAt:        PartialDate{},
+				},
+			},
+			BookLinks: []Link{
+				{ID: 8, Name: "Libraries", URL: "https://www.goodreads.com/book_link/follow/8"},
+			},
+			BuyLinks: []Link{
+				{ID: 1, Name: "Amazon", URL: "https://www.goodreads.com/book_link/follow/1"},
+				{ID: 10, Name: "Audible", URL: "https://www.goodreads.com/book_link/follow/10"},
+				{ID: 3, Name: "Barnes & Noble", URL: "https://www.goodreads.com/book_link/follow/3"},
+				{ID: 1027, Name: "Walmart eBooks", URL: "https://www.goodreads.com/book_link/follow/1027"},
+				{ID: 2102, Name: "Apple Books", URL: "https://www.goodreads.com/book_link/follow/2102"},
+				{ID: 8036, Name: "Google Play", URL: "https://www.goodreads.com/book_link/follow/8036"},
+				{ID: 4, Name: "Abebooks", URL: "https://www.goodreads.com/book_link/follow/4"},
+				{ID: 882, Name: "Book Depository", URL: "https://www.goodreads.com/book_link/follow/882"},
+				{ID: 5, Name: "Alibris", URL: "https://www.goodreads.com/book_link/follow/5"},
+				{ID: 9, Name: "Indigo", URL: "https://www.goodreads.com/book_link/follow/9"},
+				{ID: 107, Name: "Better World Books", URL: "https://www.goodreads.com/book_link/follow/107"},
+				{ID: 7, Name: "IndieBound", URL: "https://www.goodreads.com/book_link/follow/7"},
+			},
+			SeriesWorks: []SeriesWork{
+				{
+					ID:       933153,
+					Position: SeriesPosition{Raw: "1-7", Start: 1, End: 7, Valid: true},
+					Series: &Series{
+						ID:               45175,
+						Title:            "\n    Harry Potter\n",
+						Description:      "\n    Orphan Harry learns he is a wizard on his 11th birthday when Hagrid escorts him to magic-teaching Hogwarts School. As a baby, his mother's love protected him and vanquished the villain Voldemort, leaving the child famous as \"The Boy who Lived\". With his friends Hermione and Ron, Harry has to defeat the returned \"He Who Must Not Be Named\".\n",
+						Note:             "\n    Cursed Child is NOT a Primary Work. Boxsets ARE part of the series. However, the mini-shorts are NOT books by Goodreads standards, and should neither be added to this series nor to the database.\n",
+						SeriesWorksCount: 16,
+						PrimaryWorkCount: 7,
+						Numbered:         true,
+					},
 				},
 			},
-			PublicationDate: PublicationDate{
+			PublicationDate: PartialDate{
 				Year:  2007,
 				Month: 10,
 				Day:   1,
@@ -78,6 +139,97 @@ func TestClient_GetOneBook(t *testing.T) {
 		}, book)
 	})
 
+	t.Run("returns the similar books and the popular shelves", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			content, _ := ioutil.ReadFile("fixtures/get_one_book.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		book, err := client.GetOneBook(ctx, 1)
+
+		assert.NoError(t, err)
+
+		assert.Len(t, book.PopularShelves, 100)
+		assert.Equal(t, ShelfCount{Name: "to-read", Count: 44129}, book.PopularShelves[0])
+		assert.Equal(t, ShelfCount{Name: "fantasy-scifi", Count: 27}, book.PopularShelves[99])
+
+		assert.Len(t, book.SimilarBooks, 18)
+		assert.Equal(t, Book{
+			ID:            7938275,
+			Title:         "The Hunger Games Trilogy Boxset (The Hunger Games, #1-3)",
+			ImageURL:      "https://i.gr-assets.com/images/S/compressed.photo.goodreads.com/books/1360094673l/7938275._SX98_.jpg",
+			SmallImageURL: "https://i.gr-assets.com/images/S/compressed.photo.goodreads.com/books/1360094673l/7938275._SY75_.jpg",
+			NumPage:       1155,
+			ISBN:          "0545265355",
+			ISBN13:        "9780545265355",
+			AverageRating: 4.74,
+			RatingsCount:  239917,
+			Link:          "https://www.goodreads.com/book/show/7938275-the-hunger-games-trilogy-boxset",
+			Work:          Work{WorkID: 11349083},
+			Authors: []Author{
+				{ID: 153394, Name: "Suzanne Collins"},
+			},
+			PublicationDate: PartialDate{
+				Year:  2010,
+				Month: 8,
+				Day:   24,
+			},
+		}, book.SimilarBooks[0])
+		assert.Equal(t, Int(20360301), book.SimilarBooks[17].ID)
+		assert.Equal(t, Int(0), book.SimilarBooks[17].NumPage)
+	})
+
+	t.Run("leaves the empty pages, ratings and ebook flag to zero", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintln(w, `<GoodreadsResponse><book>
+				<id>862041</id>
+				<isbn><![CDATA[]]></isbn>
+				<num_pages><![CDATA[ ]]></num_pages>
+				<is_ebook><![CDATA[ ]]></is_ebook>
+				<average_rating> </average_rating>
+				<ratings_count><![CDATA[]]></ratings_count>
+				<text_reviews_count><![CDATA[ 6454 ]]></text_reviews_count>
+			</book></GoodreadsResponse>`)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		book, err := client.GetOneBook(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Book{ID: 862041, TextReviewsCount: 6454}, book)
+	})
+
+	t.Run("returns an error if a rating is malformed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintln(w, "<GoodreadsResponse><book><id>862041</id><average_rating>great</average_rating></book></GoodreadsResponse>")
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		book, err := client.GetOneBook(ctx, 1)
+
+		assert.EqualError(t, err, "failed to get the book #1: failed to decode response for '//book/show': strconv.ParseFloat: parsing \"great\": invalid syntax")
+		assert.Equal(t, Book{}, book)
+	})
+
 	t.Run("returns an error if call failed", func(t *testing.T) {
 		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, "wtf", http.StatusInternalServerError)
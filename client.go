@@ -5,19 +5,83 @@ import (
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 )
 
+// maxPages is the safety cap of the helpers fetching all the pages of an endpoint
+const maxPages = 100
+
+// ErrTooManyPages is returned by the helpers fetching all the pages of an endpoint when they hit maxPages
+var ErrTooManyPages = fmt.Errorf("stopped after %d pages", maxPages)
+
 // Client is a public interface for client
 type Client interface {
-	Search(ctx context.Context, searchQuery string, page int) ([]Work, error)
-	GetAllSeriesForWork(ctx context.Context, workID int) ([]Series, error)
-	GetOneSeries(ctx context.Context, serieID int, page int) (SeriesWithWorks, error)
-	GetOneAuthor(ctx context.Context, authorID int) (Author, error)
-	GetAuthorBooks(ctx context.Context, authorID int, page int) (AuthorWithBooks, error)
-	GetOneBook(ctx context.Context, bookID int) (Book, error)
+	Search(ctx context.Context, searchQuery string, page int, opts SearchOptions) (SearchResult, error)
+	StreamSearch(ctx context.Context, searchQuery string, opts SearchOptions) (<-chan Work, <-chan error)
+	GetAllSeriesForWork(ctx context.Context, workID Int) ([]Series, error)
+	GetOneSeries(ctx context.Context, serieID Int, page int) (SeriesWithWorks, error)
+	AllSeriesWorks(ctx context.Context, serieID Int) (SeriesWithWorks, error)
+	StreamSeriesWorks(ctx context.Context, serieID Int) (<-chan SeriesWork, <-chan error)
+	GetReadingOrders(ctx context.Context, bookID Int) ([]ReadingOrder, error)
+	GetOneAuthor(ctx context.Context, authorID Int) (Author, error)
+	GetAuthorBooks(ctx context.Context, authorID Int, page int) (AuthorWithBooks, error)
+	AllAuthorBooks(ctx context.Context, authorID Int) (AuthorWithBooks, error)
+	StreamAuthorBooks(ctx context.Context, authorID Int) (<-chan Book, <-chan error)
+	Bibliography(ctx context.Context, authorID Int) (Bibliography, error)
+	GetOneBook(ctx context.Context, bookID Int) (Book, error)
+	ListShelves(ctx context.Context, userID Int) ([]Shelf, error)
+	AddToShelf(ctx context.Context, shelf string, bookID Int) error
+	RemoveFromShelf(ctx context.Context, shelf string, bookID Int) error
+	AddBooksToShelves(ctx context.Context, bookIDs []Int, shelves []string) error
+	CreateShelf(ctx context.Context, name string, options ShelfOptions) (Shelf, error)
+	UpdateShelf(ctx context.Context, shelfID Int, name string, options ShelfOptions) (Shelf, error)
+	ListOwnedBooks(ctx context.Context, userID Int, page int) ([]OwnedBook, error)
+	GetOwnedBook(ctx context.Context, ownedBookID Int) (OwnedBook, error)
+	CreateOwnedBook(ctx context.Context, bookID Int, options OwnedBookOptions) (OwnedBook, error)
+	UpdateOwnedBook(ctx context.Context, ownedBookID Int, options OwnedBookOptions) error
+	DeleteOwnedBook(ctx context.Context, ownedBookID Int) error
+	GetUser(ctx context.Context, idOrUsername string) (User, error)
+	CompareBooks(ctx context.Context, otherUserID Int) (Comparison, error)
+	GetFriends(ctx context.Context, userID Int, page int) (UserList, error)
+	GetFollowers(ctx context.Context, userID Int, page int) (UserList, error)
+	GetFollowing(ctx context.Context, userID Int, page int) (UserList, error)
+	AddFriend(ctx context.Context, userID Int) error
+	FollowUser(ctx context.Context, userID Int) error
+	UnfollowUser(ctx context.Context, userID Int) error
+	GetFriendRequests(ctx context.Context, page int) (FriendRequests, error)
+	ConfirmFriendRequest(ctx context.Context, requestID Int, accept bool) error
+	ConfirmFriendRecommendation(ctx context.Context, recommendationID Int, accept bool) error
+	FollowAuthor(ctx context.Context, authorID Int) (AuthorFollowing, error)
+	UnfollowAuthor(ctx context.Context, followingID Int) error
+	GetAuthorFollowing(ctx context.Context, followingID Int) (AuthorFollowing, error)
+	SearchGroups(ctx context.Context, searchQuery string, page int) (GroupList, error)
+	GetGroup(ctx context.Context, groupID Int) (Group, error)
+	GetGroupMembers(ctx context.Context, groupID Int, page int, sort GroupMembersSort) (GroupMembers, error)
+	ListUserGroups(ctx context.Context, userID Int, sort GroupSort) (GroupList, error)
+	JoinGroup(ctx context.Context, groupID Int) error
+	GetTopic(ctx context.Context, topicID Int) (Topic, error)
+	GetGroupFolderTopics(ctx context.Context, folderID Int, groupID Int, page int) (TopicList, error)
+	GetUnreadGroupTopics(ctx context.Context, groupID Int, page int) (TopicList, error)
+	CreateTopic(ctx context.Context, options TopicOptions) (Topic, error)
+	ListComments(ctx context.Context, subjectType string, subjectID Int, page int) (CommentList, error)
+	CreateComment(ctx context.Context, subjectType string, subjectID Int, body string) (Comment, error)
+	CreateUserStatus(ctx context.Context, bookID Int, progress ReadingProgress, body string) (UserStatus, error)
+	DeleteUserStatus(ctx context.Context, statusID Int) error
+	GetUserStatus(ctx context.Context, statusID Int) (UserStatus, error)
+	ListUserStatuses(ctx context.Context) ([]UserStatus, error)
+	GetReadStatus(ctx context.Context, readStatusID Int) (ReadStatus, error)
+	GetFriendUpdates(ctx context.Context, filter FriendUpdatesFilter) ([]Update, error)
+	GetNotifications(ctx context.Context, page int) ([]Update, error)
+	CreateQuote(ctx context.Context, authorName string, bookID Int, body string, tags []string) (Quote, error)
+	LikeResource(ctx context.Context, resourceType string, resourceID Int) (Like, error)
+	UnlikeResource(ctx context.Context, likeID Int) error
+	GetRecommendation(ctx context.Context, recommendationID Int) (Recommendation, error)
+	ListEvents(ctx context.Context, query EventQuery) ([]Event, error)
 }
 
 // client is holding everything to interact with goodreads API
@@ -26,9 +90,35 @@ type client struct {
 	format string
 	domain string
 	http   *http.Client
+	strict func(DecodeReport)
+	rawXML bool
 }
 
+// Option changes the behaviour of the client, see the With* functions
+type Option func(*client)
+
+// Get calls the endpoint with the given query and decodes the xml response
 func (c *client) Get(ctx context.Context, endpoint string, query url.Values, response interface{}) error {
+	return c.do(ctx, http.MethodGet, endpoint, query, nil, response)
+}
+
+// Post sends the form to the endpoint, those calls need an OAuth client (see NewOAuthClient)
+// A nil response means we don't care about the body returned
+func (c *client) Post(ctx context.Context, endpoint string, form url.Values, response interface{}) error {
+	return c.do(ctx, http.MethodPost, endpoint, url.Values{}, form, response)
+}
+
+// Put same as Post but for the endpoints updating a resource
+func (c *client) Put(ctx context.Context, endpoint string, form url.Values, response interface{}) error {
+	return c.do(ctx, http.MethodPut, endpoint, url.Values{}, form, response)
+}
+
+// Delete calls the endpoint with the given query to delete a resource, needs an OAuth client
+func (c *client) Delete(ctx context.Context, endpoint string, query url.Values, response interface{}) error {
+	return c.do(ctx, http.MethodDelete, endpoint, query, nil, response)
+}
+
+func (c *client) do(ctx context.Context, method string, endpoint string, query url.Values, form url.Values, response interface{}) error {
 	query.Set("key", c.APIKey)
 	query.Set("format", c.format)
 
@@ -40,39 +130,113 @@ func (c *client) Get(ctx context.Context, endpoint string, query url.Values, res
 
 	u.RawQuery = query.Encode()
 
-	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	var body io.Reader
+
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
 
 	if err != nil {
 		return fmt.Errorf("failed to build request for '%s': %w", u.Path, err)
 	}
 
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
 	resp, err := c.http.Do(req)
 
 	if err != nil {
 		return fmt.Errorf("request failed for '%s': %w", u.Path, err)
 	}
 
+	defer resp.Body.Close()
+
 	if resp.StatusCode >= http.StatusBadRequest {
 		return fmt.Errorf("request failed for '%s': %w", u.Path, errors.New(resp.Status))
 	}
 
-	err = xml.NewDecoder(resp.Body).Decode(response)
+	if response == nil {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+
+	if c.strict == nil && !c.rawXML {
+		if err = xml.NewDecoder(resp.Body).Decode(response); err != nil {
+			return fmt.Errorf("failed to decode response for '%s': %w", u.Path, err)
+		}
+
+		return nil
+	}
+
+	content, err := ioutil.ReadAll(resp.Body)
+
+	if err != nil {
+		return fmt.Errorf("failed to read response for '%s': %w", u.Path, err)
+	}
+
+	if c.strict != nil {
+		err = c.decodeStrict(u.Path, content, response)
+	} else {
+		err = xml.Unmarshal(content, response)
+	}
 
 	if err != nil {
 		return fmt.Errorf("failed to decode response for '%s': %w", u.Path, err)
 	}
 
+	if c.rawXML {
+		fillRawXML(content, response)
+	}
+
 	return nil
 }
 
 // NewClient creates a new goodreads api client with the given api key
-func NewClient(apikey string) Client {
-	return client{
+func NewClient(apikey string, opts ...Option) Client {
+	c := client{
+		APIKey: apikey,
+		format: "xml",
+		domain: "https://www.goodreads.com",
+		http: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
+}
+
+// NewOAuthClient creates a new goodreads api client acting on behalf of a user.
+// The access token and its secret must already be obtained through the OAuth flow,
+// see https://www.goodreads.com/api/documentation#oauth
+func NewOAuthClient(apikey string, apisecret string, token string, tokenSecret string, opts ...Option) Client {
+	c := client{
 		APIKey: apikey,
 		format: "xml",
 		domain: "https://www.goodreads.com",
 		http: &http.Client{
 			Timeout: 10 * time.Second,
+			Transport: &oauthTransport{
+				consumerKey:    apikey,
+				consumerSecret: apisecret,
+				token:          token,
+				tokenSecret:    tokenSecret,
+				base:           http.DefaultTransport,
+				nonce:          randomNonce,
+				now:            time.Now,
+			},
 		},
 	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
 }
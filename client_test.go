@@ -31,6 +31,25 @@ func TestNewClient(t *testing.T) {
 	})
 }
 
+func TestNewOAuthClient(t *testing.T) {
+	t.Run("it returns a new client signing its requests with the given credentials", func(t *testing.T) {
+		gr := NewOAuthClient("awesomesuperapikey11", "secret", "token", "tokensecret").(client)
+
+		assert.Equal(t, "awesomesuperapikey11", gr.APIKey)
+		assert.Equal(t, "xml", gr.format)
+		assert.Equal(t, "https://www.goodreads.com", gr.domain)
+		assert.Equal(t, 10*time.Second, gr.http.Timeout)
+
+		transport := gr.http.Transport.(*oauthTransport)
+
+		assert.Equal(t, "awesomesuperapikey11", transport.consumerKey)
+		assert.Equal(t, "secret", transport.consumerSecret)
+		assert.Equal(t, "token", transport.token)
+		assert.Equal(t, "tokensecret", transport.tokenSecret)
+		assert.Equal(t, http.DefaultTransport, transport.base)
+	})
+}
+
 func TestClient_Get(t *testing.T) {
 	var ctx = context.TODO()
 
@@ -94,7 +113,8 @@ func TestClient_Get(t *testing.T) {
 	})
 
 	t.Run("it returns an error when the requests fails on our side", func(t *testing.T) {
-		var expiredCtx, _ = context.WithTimeout(ctx, 0*time.Second)
+		expiredCtx, cancel := context.WithTimeout(ctx, 0*time.Second)
+		defer cancel()
 
 		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			http.NotFound(w, r)
@@ -152,3 +172,106 @@ func TestClient_Get(t *testing.T) {
 		assert.EqualError(t, err, "failed to decode response for '/bar': EOF")
 	})
 }
+
+func TestClient_Post(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("it sends the form and decodes the response into the given struct", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+			assert.Equal(t, "xml", r.URL.Query().Get("format"))
+			assert.Equal(t, "baz", r.PostFormValue("foo"))
+
+			_, _ = fmt.Fprintln(w, "<foo><bar>hello</bar></foo>")
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+			format: "xml",
+		}
+		resp := fakeResponse{}
+
+		err := client.Post(ctx, "bar", url.Values{"foo": {"baz"}}, &resp)
+
+		assert.NoError(t, err)
+		assert.Equal(t, fakeResponse{Bar: "hello"}, resp)
+	})
+
+	t.Run("it ignores the body when no response is expected", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.Post(ctx, "bar", url.Values{}, nil)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("it returns an error when the server returns an HTTP error (>= 400)", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.Post(ctx, "bar", url.Values{}, nil)
+
+		assert.EqualError(t, err, "request failed for '/bar': 401 Unauthorized")
+	})
+}
+
+func TestClient_Put(t *testing.T) {
+	t.Run("it sends the form with the PUT method", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "baz", r.PostFormValue("foo"))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.Put(context.TODO(), "bar", url.Values{"foo": {"baz"}}, nil)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestClient_Delete(t *testing.T) {
+	t.Run("it sends the query with the DELETE method", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			assert.Equal(t, "baz", r.URL.Query().Get("foo"))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.Delete(context.TODO(), "bar", url.Values{"foo": {"baz"}}, nil)
+
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,57 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type listCommentsResponse struct {
+	Comments CommentList `xml:"comments"`
+}
+
+type createCommentResponse struct {
+	Comment Comment `xml:"comment"`
+}
+
+// ListComments returns a paginated list of the comments on any resource
+// subjectType is the kind of resource in snake case, e.g. "topic", "review", "user_status"
+func (c client) ListComments(ctx context.Context, subjectType string, subjectID Int, page int) (CommentList, error) {
+	var response = listCommentsResponse{
+		CommentList{
+			Comments: []Comment{},
+		},
+	}
+
+	q := url.Values{}
+	q.Set("type", subjectType)
+	q.Set("id", strconv.Itoa(int(subjectID)))
+	q.Set("page", strconv.Itoa(page))
+
+	err := c.Get(ctx, "/comment", q, &response)
+
+	if err != nil {
+		return CommentList{}, fmt.Errorf("failed to get the comments of the %s #%d in page #%d: %w", subjectType, subjectID, page, err)
+	}
+
+	return response.Comments, nil
+}
+
+// CreateComment comments on any resource, see ListComments for the subject type
+func (c client) CreateComment(ctx context.Context, subjectType string, subjectID Int, body string) (Comment, error) {
+	var response = createCommentResponse{}
+
+	form := url.Values{}
+	form.Set("type", subjectType)
+	form.Set("id", strconv.Itoa(int(subjectID)))
+	form.Set("comment[body]", body)
+
+	err := c.Post(ctx, "/comment", form, &response)
+
+	if err != nil {
+		return Comment{}, fmt.Errorf("failed to comment the %s #%d: %w", subjectType, subjectID, err)
+	}
+
+	return response.Comment, nil
+}
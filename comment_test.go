@@ -0,0 +1,124 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var imInComment = Comment{
+	ID:   200734519,
+	Body: "I'm in!",
+	User: User{
+		ID:   3,
+		Name: "Jonathan",
+	},
+	CreatedAt: "2020-05-03T21:14:27-07:00",
+	UpdatedAt: "2020-05-03T21:14:27-07:00",
+}
+
+func TestClient_ListComments(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the page of comments", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//comment", r.URL.Path)
+			assert.Equal(t, "topic", r.URL.Query().Get("type"))
+			assert.Equal(t, "20318032", r.URL.Query().Get("id"))
+			assert.Equal(t, "2", r.URL.Query().Get("page"))
+
+			content, _ := ioutil.ReadFile("fixtures/list_comments.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		comments, err := client.ListComments(ctx, "topic", 20318032, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, CommentList{
+			Pagination: Pagination{
+				Start: 21,
+				End:   21,
+				Total: 21,
+			},
+			Comments: []Comment{imInComment},
+		}, comments)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		comments, err := client.ListComments(ctx, "topic", 20318032, 2)
+
+		assert.EqualError(t, err, "failed to get the comments of the topic #20318032 in page #2: request failed for '//comment': 500 Internal Server Error")
+		assert.Equal(t, CommentList{}, comments)
+	})
+}
+
+func TestClient_CreateComment(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts the comment and returns the created one", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "//comment", r.URL.Path)
+			assert.Equal(t, "topic", r.PostFormValue("type"))
+			assert.Equal(t, "20318032", r.PostFormValue("id"))
+			assert.Equal(t, "I'm in!", r.PostFormValue("comment[body]"))
+
+			content, _ := ioutil.ReadFile("fixtures/create_comment.xml")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		comment, err := client.CreateComment(ctx, "topic", 20318032, "I'm in!")
+
+		assert.NoError(t, err)
+		assert.Equal(t, imInComment, comment)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		comment, err := client.CreateComment(ctx, "topic", 20318032, "I'm in!")
+
+		assert.EqualError(t, err, "failed to comment the topic #20318032: request failed for '//comment': 401 Unauthorized")
+		assert.Equal(t, Comment{}, comment)
+	})
+}
@@ -0,0 +1,123 @@
+package goodreads
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EventQuery where to look for events
+// Lat and Lng are only sent when at least one of them is set, same for the other empty values
+type EventQuery struct {
+	Lat         float64
+	Lng         float64
+	CountryCode string
+	PostalCode  string
+}
+
+type listEventsResponse struct {
+	Events []Event `xml:"events>event"`
+}
+
+// eventTimeLayouts the formats Goodreads uses for the event dates
+var eventTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05 MST",
+}
+
+// ListEvents returns the events near a location
+func (c client) ListEvents(ctx context.Context, query EventQuery) ([]Event, error) {
+	var response = listEventsResponse{
+		Events: []Event{},
+	}
+
+	q := url.Values{}
+
+	if query.Lat != 0 || query.Lng != 0 {
+		q.Set("lat", strconv.FormatFloat(query.Lat, 'f', -1, 64))
+		q.Set("lng", strconv.FormatFloat(query.Lng, 'f', -1, 64))
+	}
+
+	if query.CountryCode != "" {
+		q.Set("search[country_code]", query.CountryCode)
+	}
+
+	if query.PostalCode != "" {
+		q.Set("search[postal_code]", query.PostalCode)
+	}
+
+	err := c.Get(ctx, "/event/index", q, &response)
+
+	if err != nil {
+		return []Event{}, fmt.Errorf("failed to list the events: %w", err)
+	}
+
+	return response.Events, nil
+}
+
+// event has the same fields as Event without the custom unmarshaler
+type event Event
+
+// UnmarshalXML decodes the dates of the event, empty or invalid dates are left to zero
+// (strict mode reports the invalid ones)
+func (e *Event) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var raw struct {
+		event
+		StartAt string `xml:"start_at"`
+		EndAt   string `xml:"end_at"`
+	}
+
+	if err := d.DecodeElement(&raw, &start); err != nil {
+		return err
+	}
+
+	*e = Event(raw.event)
+	e.StartAt, _ = parseEventTime(raw.StartAt)
+	e.EndAt, _ = parseEventTime(raw.EndAt)
+
+	return nil
+}
+
+func (Event) handDecodedElements() map[string]reflect.Type {
+	return map[string]reflect.Type{
+		"start_at": reflect.TypeOf(eventTime{}),
+		"end_at":   reflect.TypeOf(eventTime{}),
+	}
+}
+
+// eventTime checks the event dates in strict mode
+type eventTime time.Time
+
+func (t *eventTime) UnmarshalText(text []byte) error {
+	parsed, err := parseEventTime(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*t = eventTime(parsed)
+
+	return nil
+}
+
+func parseEventTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range eventTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("could not parse the event time '%s'", value)
+}
@@ -0,0 +1,157 @@
+package goodreads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ListEvents(t *testing.T) {
+	var ctx = context.TODO()
+	var pdt = time.FixedZone("", -7*60*60)
+
+	t.Run("returns the events near the location", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//event/index", r.URL.Path)
+			assert.Equal(t, "45.5231", r.URL.Query().Get("lat"))
+			assert.Equal(t, "-122.6813", r.URL.Query().Get("lng"))
+			assert.Equal(t, "US", r.URL.Query().Get("search[country_code]"))
+			assert.Equal(t, "97209", r.URL.Query().Get("search[postal_code]"))
+
+			content, _ := ioutil.ReadFile("fixtures/list_events.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		events, err := client.ListEvents(ctx, EventQuery{Lat: 45.5231, Lng: -122.6813, CountryCode: "US", PostalCode: "97209"})
+
+		assert.NoError(t, err)
+		assert.Len(t, events, 2)
+		assert.Equal(t, Event{
+			ID:             1473295,
+			Title:          "Nicholas Eames signing Kings of the Wyld",
+			Description:    "Come meet Nicholas Eames at the store!",
+			EventType:      "signing",
+			Access:         "public",
+			Venue:          "Powell's City of Books",
+			Address:        "1005 W Burnside St",
+			City:           "Portland",
+			State:          "OR",
+			CountryCode:    "US",
+			PostalCode:     "97209",
+			Latitude:       45.5231,
+			Longitude:      -122.6813,
+			Link:           "https://www.goodreads.com/event/show/1473295",
+			ImageURL:       "https://images.gr-assets.com/events/1473295.jpg",
+			ResourceType:   "Book",
+			ResourceID:     30841984,
+			ResourceURL:    "https://www.goodreads.com/book/show/30841984",
+			AttendingCount: 42,
+			ResponsesCount: 57,
+		}, withoutEventTimes(events[0]))
+		assert.True(t, time.Date(2020, 6, 12, 19, 0, 0, 0, pdt).Equal(events[0].StartAt))
+		assert.True(t, time.Date(2020, 6, 12, 21, 0, 0, 0, pdt).Equal(events[0].EndAt))
+	})
+
+	t.Run("leaves the empty values to zero", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			content, _ := ioutil.ReadFile("fixtures/list_events.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		events, err := client.ListEvents(ctx, EventQuery{PostalCode: "97209"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, Int(1473301), events[1].ID)
+		assert.True(t, time.Date(2020, 6, 15, 18, 30, 0, 0, pdt).Equal(events[1].StartAt))
+		assert.True(t, events[1].EndAt.IsZero())
+		assert.Equal(t, Float(0.0), events[1].Latitude)
+		assert.Equal(t, Int(0), events[1].ResourceID)
+	})
+
+	t.Run("does not send the empty query values", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "97209", r.URL.Query().Get("search[postal_code]"))
+			assert.NotContains(t, r.URL.Query(), "lat")
+			assert.NotContains(t, r.URL.Query(), "lng")
+			assert.NotContains(t, r.URL.Query(), "search[country_code]")
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		_, _ = client.ListEvents(ctx, EventQuery{PostalCode: "97209"})
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		events, err := client.ListEvents(ctx, EventQuery{PostalCode: "97209"})
+
+		assert.EqualError(t, err, "failed to list the events: request failed for '//event/index': 500 Internal Server Error")
+		assert.Equal(t, []Event{}, events)
+	})
+
+	t.Run("leaves a malformed date to zero", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintln(w, "<GoodreadsResponse><events><event><id>1</id><start_at>tomorrow</start_at><end_at>2020-06-11T21:00:00-07:00</end_at></event></events></GoodreadsResponse>")
+		}))
+		defer ts.Close()
+
+		reports := []DecodeReport{}
+		client := strictClient(ts, &reports)
+
+		events, err := client.ListEvents(ctx, EventQuery{PostalCode: "97209"})
+
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+		assert.True(t, events[0].StartAt.IsZero())
+		assert.Equal(t, "2020-06-11T21:00:00-07:00", events[0].EndAt.Format(time.RFC3339))
+		assert.Equal(t, []ConversionError{{
+			Path:  "GoodreadsResponse>events>event>start_at",
+			Value: "tomorrow",
+			Type:  "goodreads.eventTime",
+			Err:   errors.New("could not parse the event time 'tomorrow'"),
+		}}, reports[0].ConversionErrors)
+	})
+}
+
+func withoutEventTimes(e Event) Event {
+	e.StartAt = time.Time{}
+	e.EndAt = time.Time{}
+
+	return e
+}
@@ -0,0 +1,175 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type getFriendsResponse struct {
+	Friends UserList `xml:"friends"`
+}
+
+type getFollowersResponse struct {
+	Followers UserList `xml:"followers"`
+}
+
+type getFollowingResponse struct {
+	Following UserList `xml:"following"`
+}
+
+type getFriendRequestsResponse struct {
+	FriendRequests FriendRequests `xml:"requests>friend_requests"`
+}
+
+// GetFriends returns a paginated list of the user's friends
+func (c client) GetFriends(ctx context.Context, userID Int, page int) (UserList, error) {
+	var response = getFriendsResponse{
+		UserList{
+			Users: []User{},
+		},
+	}
+
+	q := url.Values{}
+	q.Set("page", strconv.Itoa(page))
+
+	err := c.Get(ctx, fmt.Sprintf("/friend/user/%d", userID), q, &response)
+
+	if err != nil {
+		return UserList{}, fmt.Errorf("failed to get the friends of the user #%d in page #%d: %w", userID, page, err)
+	}
+
+	return response.Friends, nil
+}
+
+// GetFollowers returns a paginated list of the members following the user
+func (c client) GetFollowers(ctx context.Context, userID Int, page int) (UserList, error) {
+	var response = getFollowersResponse{
+		UserList{
+			Users: []User{},
+		},
+	}
+
+	q := url.Values{}
+	q.Set("page", strconv.Itoa(page))
+
+	err := c.Get(ctx, fmt.Sprintf("/user/%d/followers", userID), q, &response)
+
+	if err != nil {
+		return UserList{}, fmt.Errorf("failed to get the followers of the user #%d in page #%d: %w", userID, page, err)
+	}
+
+	return response.Followers, nil
+}
+
+// GetFollowing returns a paginated list of the members the user is following
+func (c client) GetFollowing(ctx context.Context, userID Int, page int) (UserList, error) {
+	var response = getFollowingResponse{
+		UserList{
+			Users: []User{},
+		},
+	}
+
+	q := url.Values{}
+	q.Set("page", strconv.Itoa(page))
+
+	err := c.Get(ctx, fmt.Sprintf("/user/%d/following", userID), q, &response)
+
+	if err != nil {
+		return UserList{}, fmt.Errorf("failed to get the users followed by the user #%d in page #%d: %w", userID, page, err)
+	}
+
+	return response.Following, nil
+}
+
+// AddFriend sends a friend request to the user
+func (c client) AddFriend(ctx context.Context, userID Int) error {
+	form := url.Values{}
+	form.Set("id", strconv.Itoa(int(userID)))
+
+	err := c.Post(ctx, "/friend/add_as_friend", form, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to add the user #%d as friend: %w", userID, err)
+	}
+
+	return nil
+}
+
+// FollowUser makes the authenticated user follow the given user
+func (c client) FollowUser(ctx context.Context, userID Int) error {
+	err := c.Post(ctx, fmt.Sprintf("/user/%d/followers", userID), url.Values{}, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to follow the user #%d: %w", userID, err)
+	}
+
+	return nil
+}
+
+// UnfollowUser makes the authenticated user stop following the given user
+func (c client) UnfollowUser(ctx context.Context, userID Int) error {
+	err := c.Delete(ctx, fmt.Sprintf("/user/%d/followers/stop_following", userID), url.Values{}, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to unfollow the user #%d: %w", userID, err)
+	}
+
+	return nil
+}
+
+// GetFriendRequests returns a paginated list of the pending friend requests of the authenticated user
+func (c client) GetFriendRequests(ctx context.Context, page int) (FriendRequests, error) {
+	var response = getFriendRequestsResponse{
+		FriendRequests{
+			Requests: []FriendRequest{},
+		},
+	}
+
+	q := url.Values{}
+	q.Set("page", strconv.Itoa(page))
+
+	err := c.Get(ctx, "/friend/requests", q, &response)
+
+	if err != nil {
+		return FriendRequests{}, fmt.Errorf("failed to get the friend requests in page #%d: %w", page, err)
+	}
+
+	return response.FriendRequests, nil
+}
+
+// ConfirmFriendRequest accepts or declines a friend request
+func (c client) ConfirmFriendRequest(ctx context.Context, requestID Int, accept bool) error {
+	err := c.Post(ctx, "/friend/confirm_request", confirmForm(requestID, accept), nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to confirm the friend request #%d: %w", requestID, err)
+	}
+
+	return nil
+}
+
+// ConfirmFriendRecommendation accepts or declines a friend recommendation
+func (c client) ConfirmFriendRecommendation(ctx context.Context, recommendationID Int, accept bool) error {
+	err := c.Post(ctx, "/friend/confirm_recommendation", confirmForm(recommendationID, accept), nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to confirm the friend recommendation #%d: %w", recommendationID, err)
+	}
+
+	return nil
+}
+
+// confirmForm goodreads expects Y or N as response
+func confirmForm(id Int, accept bool) url.Values {
+	form := url.Values{}
+	form.Set("id", strconv.Itoa(int(id)))
+	form.Set("response", "N")
+
+	if accept {
+		form.Set("response", "Y")
+	}
+
+	return form
+}
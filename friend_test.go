@@ -0,0 +1,423 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var friendsPage = UserList{
+	Pagination: Pagination{
+		Start: 1,
+		End:   2,
+		Total: 31,
+	},
+	Users: []User{
+		{
+			ID:            2,
+			Name:          "Elizabeth",
+			Link:          "https://www.goodreads.com/user/show/2-elizabeth",
+			ImageURL:      "https://images.gr-assets.com/users/1/2.jpg",
+			SmallImageURL: "https://images.gr-assets.com/users/1/2s.jpg",
+			FriendsCount:  420,
+			ReviewsCount:  1233,
+		},
+		{
+			ID:            3,
+			Name:          "Jonathan",
+			Link:          "https://www.goodreads.com/user/show/3-jonathan",
+			ImageURL:      "https://s.gr-assets.com/assets/nophoto/user/u_111x148.png",
+			SmallImageURL: "https://s.gr-assets.com/assets/nophoto/user/u_50x66.png",
+			FriendsCount:  12,
+			ReviewsCount:  7,
+		},
+	},
+}
+
+func TestClient_GetFriends(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the page of friends", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//friend/user/1", r.URL.Path)
+			assert.Equal(t, "1", r.URL.Query().Get("page"))
+
+			content, _ := ioutil.ReadFile("fixtures/get_friends.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		friends, err := client.GetFriends(ctx, 1, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, friendsPage, friends)
+		assert.True(t, friends.HasNextPage())
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		friends, err := client.GetFriends(ctx, 1, 1)
+
+		assert.EqualError(t, err, "failed to get the friends of the user #1 in page #1: request failed for '//friend/user/1': 500 Internal Server Error")
+		assert.Equal(t, UserList{}, friends)
+	})
+}
+
+func TestClient_GetFollowers(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the page of followers", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//user/1/followers", r.URL.Path)
+			assert.Equal(t, "1", r.URL.Query().Get("page"))
+
+			content, _ := ioutil.ReadFile("fixtures/get_followers.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		followers, err := client.GetFollowers(ctx, 1, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, friendsPage, followers)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		followers, err := client.GetFollowers(ctx, 1, 1)
+
+		assert.EqualError(t, err, "failed to get the followers of the user #1 in page #1: request failed for '//user/1/followers': 500 Internal Server Error")
+		assert.Equal(t, UserList{}, followers)
+	})
+}
+
+func TestClient_GetFollowing(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the page of followed users", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//user/1/following", r.URL.Path)
+			assert.Equal(t, "1", r.URL.Query().Get("page"))
+
+			content, _ := ioutil.ReadFile("fixtures/get_following.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		following, err := client.GetFollowing(ctx, 1, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, friendsPage, following)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		following, err := client.GetFollowing(ctx, 1, 1)
+
+		assert.EqualError(t, err, "failed to get the users followed by the user #1 in page #1: request failed for '//user/1/following': 500 Internal Server Error")
+		assert.Equal(t, UserList{}, following)
+	})
+}
+
+func TestClient_AddFriend(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts the friend request", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "//friend/add_as_friend", r.URL.Path)
+			assert.Equal(t, "3", r.PostFormValue("id"))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		assert.NoError(t, client.AddFriend(ctx, 3))
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.AddFriend(ctx, 3)
+
+		assert.EqualError(t, err, "failed to add the user #3 as friend: request failed for '//friend/add_as_friend': 401 Unauthorized")
+	})
+}
+
+func TestClient_FollowUser(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts to the user's followers", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "//user/3/followers", r.URL.Path)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		assert.NoError(t, client.FollowUser(ctx, 3))
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.FollowUser(ctx, 3)
+
+		assert.EqualError(t, err, "failed to follow the user #3: request failed for '//user/3/followers': 401 Unauthorized")
+	})
+}
+
+func TestClient_UnfollowUser(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("deletes the following", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			assert.Equal(t, "//user/3/followers/stop_following", r.URL.Path)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		assert.NoError(t, client.UnfollowUser(ctx, 3))
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.UnfollowUser(ctx, 3)
+
+		assert.EqualError(t, err, "failed to unfollow the user #3: request failed for '//user/3/followers/stop_following': 404 Not Found")
+	})
+}
+
+func TestClient_GetFriendRequests(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the page of friend requests", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//friend/requests", r.URL.Path)
+
+			content, _ := ioutil.ReadFile("fixtures/get_friend_requests.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		requests, err := client.GetFriendRequests(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, FriendRequests{
+			Pagination: Pagination{
+				Start: 1,
+				End:   1,
+				Total: 1,
+			},
+			Requests: []FriendRequest{
+				{
+					ID:        8871,
+					CreatedAt: "2020-04-02T10:12:44-07:00",
+					Message:   "we met at the book club",
+					FromUser: User{
+						ID:   3,
+						Name: "Jonathan",
+						Link: "https://www.goodreads.com/user/show/3-jonathan",
+					},
+				},
+			},
+		}, requests)
+		assert.False(t, requests.HasNextPage())
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		requests, err := client.GetFriendRequests(ctx, 1)
+
+		assert.EqualError(t, err, "failed to get the friend requests in page #1: request failed for '//friend/requests': 401 Unauthorized")
+		assert.Equal(t, FriendRequests{}, requests)
+	})
+}
+
+func TestClient_ConfirmFriendRequest(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts Y to accept the request", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//friend/confirm_request", r.URL.Path)
+			assert.Equal(t, "8871", r.PostFormValue("id"))
+			assert.Equal(t, "Y", r.PostFormValue("response"))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		assert.NoError(t, client.ConfirmFriendRequest(ctx, 8871, true))
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.ConfirmFriendRequest(ctx, 8871, true)
+
+		assert.EqualError(t, err, "failed to confirm the friend request #8871: request failed for '//friend/confirm_request': 404 Not Found")
+	})
+}
+
+func TestClient_ConfirmFriendRecommendation(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts N to decline the recommendation", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//friend/confirm_recommendation", r.URL.Path)
+			assert.Equal(t, "51", r.PostFormValue("id"))
+			assert.Equal(t, "N", r.PostFormValue("response"))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		assert.NoError(t, client.ConfirmFriendRecommendation(ctx, 51, false))
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.ConfirmFriendRecommendation(ctx, 51, false)
+
+		assert.EqualError(t, err, "failed to confirm the friend recommendation #51: request failed for '//friend/confirm_recommendation': 404 Not Found")
+	})
+}
@@ -0,0 +1,143 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// GroupSort how to sort the groups of a user
+type GroupSort string
+
+// All the ways to sort the groups of a user
+const (
+	GroupSortMyActivity   GroupSort = "my_activity"
+	GroupSortMembers      GroupSort = "members"
+	GroupSortLastActivity GroupSort = "last_activity"
+	GroupSortTitle        GroupSort = "title"
+)
+
+// GroupMembersSort how to sort the members of a group
+type GroupMembersSort string
+
+// All the ways to sort the members of a group
+const (
+	GroupMembersSortLastOnline  GroupMembersSort = "last_online"
+	GroupMembersSortNumComments GroupMembersSort = "num_comments"
+	GroupMembersSortDateJoined  GroupMembersSort = "date_joined"
+	GroupMembersSortTitle       GroupMembersSort = "title"
+)
+
+type searchGroupsResponse struct {
+	Groups GroupList `xml:"groups>list"`
+}
+
+type getGroupResponse struct {
+	Group Group `xml:"group"`
+}
+
+type getGroupMembersResponse struct {
+	Members GroupMembers `xml:"group_users"`
+}
+
+type listUserGroupsResponse struct {
+	Groups GroupList `xml:"groups"`
+}
+
+// SearchGroups find groups by title or description
+func (c client) SearchGroups(ctx context.Context, searchQuery string, page int) (GroupList, error) {
+	var response = searchGroupsResponse{
+		GroupList{
+			Groups: []Group{},
+		},
+	}
+
+	q := url.Values{}
+	q.Set("q", searchQuery)
+	q.Set("page", strconv.Itoa(page))
+
+	err := c.Get(ctx, "/group/search", q, &response)
+
+	if err != nil {
+		return GroupList{}, fmt.Errorf("'%s' group search at page %d failed: %w", searchQuery, page, err)
+	}
+
+	return response.Groups, nil
+}
+
+// GetGroup returns the details of the given group ID
+func (c client) GetGroup(ctx context.Context, groupID Int) (Group, error) {
+	var response = getGroupResponse{}
+
+	err := c.Get(ctx, fmt.Sprintf("/group/show/%d", groupID), url.Values{}, &response)
+
+	if err != nil {
+		return Group{}, fmt.Errorf("failed to get the group #%d: %w", groupID, err)
+	}
+
+	return response.Group, nil
+}
+
+// GetGroupMembers returns a paginated list of the members of the group
+// An empty sort uses the goodreads default
+func (c client) GetGroupMembers(ctx context.Context, groupID Int, page int, sort GroupMembersSort) (GroupMembers, error) {
+	var response = getGroupMembersResponse{
+		GroupMembers{
+			Members: []GroupMember{},
+		},
+	}
+
+	q := url.Values{}
+	q.Set("page", strconv.Itoa(page))
+
+	if sort != "" {
+		q.Set("sort", string(sort))
+	}
+
+	err := c.Get(ctx, fmt.Sprintf("/group/members/%d", groupID), q, &response)
+
+	if err != nil {
+		return GroupMembers{}, fmt.Errorf("failed to get the members of the group #%d in page #%d: %w", groupID, page, err)
+	}
+
+	return response.Members, nil
+}
+
+// ListUserGroups returns the groups the user is a member of
+// An empty sort uses the goodreads default
+func (c client) ListUserGroups(ctx context.Context, userID Int, sort GroupSort) (GroupList, error) {
+	var response = listUserGroupsResponse{
+		GroupList{
+			Groups: []Group{},
+		},
+	}
+
+	q := url.Values{}
+
+	if sort != "" {
+		q.Set("sort", string(sort))
+	}
+
+	err := c.Get(ctx, fmt.Sprintf("/group/list/%d", userID), q, &response)
+
+	if err != nil {
+		return GroupList{}, fmt.Errorf("failed to get the groups of the user #%d: %w", userID, err)
+	}
+
+	return response.Groups, nil
+}
+
+// JoinGroup makes the authenticated user join the group
+func (c client) JoinGroup(ctx context.Context, groupID Int) error {
+	form := url.Values{}
+	form.Set("id", strconv.Itoa(int(groupID)))
+
+	err := c.Post(ctx, "/group/join", form, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to join the group #%d: %w", groupID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,330 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var fantasyBookClub = Group{
+	ID:             1865,
+	Title:          "Fantasy Book Club",
+	Access:         "public",
+	MembersCount:   21634,
+	ImageURL:       "https://images.gr-assets.com/groups/1865.jpg",
+	LastActivityAt: "2020-05-11T14:12:51-07:00",
+}
+
+func TestClient_SearchGroups(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the page of groups found", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//group/search", r.URL.Path)
+			assert.Equal(t, "fantasy", r.URL.Query().Get("q"))
+			assert.Equal(t, "1", r.URL.Query().Get("page"))
+
+			content, _ := ioutil.ReadFile("fixtures/search_groups.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		groups, err := client.SearchGroups(ctx, "fantasy", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, GroupList{
+			Pagination: Pagination{
+				Start: 1,
+				End:   2,
+				Total: 57,
+			},
+			Groups: []Group{
+				fantasyBookClub,
+				{
+					ID:             50093,
+					Title:          "Grimdark Readers",
+					Access:         "restricted",
+					MembersCount:   312,
+					ImageURL:       "https://images.gr-assets.com/groups/50093.jpg",
+					LastActivityAt: "2020-05-01T09:02:11-07:00",
+				},
+			},
+		}, groups)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		groups, err := client.SearchGroups(ctx, "fantasy", 1)
+
+		assert.EqualError(t, err, "'fantasy' group search at page 1 failed: request failed for '//group/search': 500 Internal Server Error")
+		assert.Equal(t, GroupList{}, groups)
+	})
+}
+
+func TestClient_GetGroup(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the group", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//group/show/1865", r.URL.Path)
+
+			content, _ := ioutil.ReadFile("fixtures/get_group.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		group, err := client.GetGroup(ctx, 1865)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Group{
+			ID:           1865,
+			Title:        "Fantasy Book Club",
+			Access:       "public",
+			Location:     "Paris, France",
+			MembersCount: 21634,
+			Description:  "A place to talk about <b>fantasy</b> books.",
+			ImageURL:     "https://images.gr-assets.com/groups/1865.jpg",
+			Category:     "Books & Literature",
+			Subcategory:  "Fantasy",
+			Moderators: []User{
+				{
+					ID:   2,
+					Name: "Elizabeth",
+					Link: "https://www.goodreads.com/user/show/2-elizabeth",
+				},
+			},
+			CurrentlyReading: []Book{
+				{
+					ID:    30841984,
+					Title: "Kings of the Wyld (The Band, #1)",
+				},
+			},
+		}, group)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		group, err := client.GetGroup(ctx, 1865)
+
+		assert.EqualError(t, err, "failed to get the group #1865: request failed for '//group/show/1865': 404 Not Found")
+		assert.Equal(t, Group{}, group)
+	})
+}
+
+func TestClient_GetGroupMembers(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the page of members", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//group/members/1865", r.URL.Path)
+			assert.Equal(t, "2", r.URL.Query().Get("page"))
+			assert.Equal(t, "num_comments", r.URL.Query().Get("sort"))
+
+			content, _ := ioutil.ReadFile("fixtures/get_group_members.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		members, err := client.GetGroupMembers(ctx, 1865, 2, GroupMembersSortNumComments)
+
+		assert.NoError(t, err)
+		assert.Equal(t, GroupMembers{
+			Pagination: Pagination{
+				Start: 1,
+				End:   2,
+				Total: 21634,
+			},
+			Members: []GroupMember{
+				{
+					User: User{
+						ID:   2,
+						Name: "Elizabeth",
+						Link: "https://www.goodreads.com/user/show/2-elizabeth",
+					},
+					Title:         "moderator",
+					CommentsCount: 1204,
+					JoinedAt:      "2008-02-12T10:00:00-08:00",
+				},
+				{
+					User: User{
+						ID:   3,
+						Name: "Jonathan",
+						Link: "https://www.goodreads.com/user/show/3-jonathan",
+					},
+					CommentsCount: 3,
+					JoinedAt:      "2019-11-02T18:45:03-07:00",
+				},
+			},
+		}, members)
+	})
+
+	t.Run("does not send the sort when empty", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.NotContains(t, r.URL.Query(), "sort")
+
+			content, _ := ioutil.ReadFile("fixtures/get_group_members.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		_, err := client.GetGroupMembers(ctx, 1865, 2, "")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		members, err := client.GetGroupMembers(ctx, 1865, 2, GroupMembersSortTitle)
+
+		assert.EqualError(t, err, "failed to get the members of the group #1865 in page #2: request failed for '//group/members/1865': 500 Internal Server Error")
+		assert.Equal(t, GroupMembers{}, members)
+	})
+}
+
+func TestClient_ListUserGroups(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the groups of the user", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//group/list/1", r.URL.Path)
+			assert.Equal(t, "title", r.URL.Query().Get("sort"))
+
+			content, _ := ioutil.ReadFile("fixtures/list_user_groups.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		groups, err := client.ListUserGroups(ctx, 1, GroupSortTitle)
+
+		assert.NoError(t, err)
+		assert.Equal(t, GroupList{
+			Pagination: Pagination{
+				Start: 1,
+				End:   1,
+				Total: 1,
+			},
+			Groups: []Group{fantasyBookClub},
+		}, groups)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		groups, err := client.ListUserGroups(ctx, 1, "")
+
+		assert.EqualError(t, err, "failed to get the groups of the user #1: request failed for '//group/list/1': 500 Internal Server Error")
+		assert.Equal(t, GroupList{}, groups)
+	})
+}
+
+func TestClient_JoinGroup(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts the group to join", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "//group/join", r.URL.Path)
+			assert.Equal(t, "1865", r.PostFormValue("id"))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		assert.NoError(t, client.JoinGroup(ctx, 1865))
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.JoinGroup(ctx, 1865)
+
+		assert.EqualError(t, err, "failed to join the group #1865: request failed for '//group/join': 401 Unauthorized")
+	})
+}
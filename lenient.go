@@ -0,0 +1,54 @@
+package goodreads
+
+// The numbers and flags of the models use the types below: encoding/xml accepts an empty
+// value for an int, a float or a bool but fails on a blank one (" ", "\n"...), which
+// Goodreads sends now and then, failing the whole response
+// A value which is not a number (or a bool) still fails the decoding
+
+// Int an int left to 0 when Goodreads sends an empty or blank value
+type Int int
+
+// Float a float left to 0 when Goodreads sends an empty or blank value
+type Float float64
+
+// Bool a bool left to false when Goodreads sends an empty or blank value
+type Bool bool
+
+// UnmarshalText decodes the trimmed value, blank is 0
+func (i *Int) UnmarshalText(text []byte) error {
+	n, err := parseOptionalInt(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*i = Int(n)
+
+	return nil
+}
+
+// UnmarshalText decodes the trimmed value, blank is 0
+func (f *Float) UnmarshalText(text []byte) error {
+	n, err := parseOptionalFloat(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*f = Float(n)
+
+	return nil
+}
+
+// UnmarshalText decodes the trimmed value, blank is false
+func (b *Bool) UnmarshalText(text []byte) error {
+	v, err := parseOptionalBool(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*b = Bool(v)
+
+	return nil
+}
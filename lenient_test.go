@@ -0,0 +1,47 @@
+package goodreads
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type lenientValues struct {
+	Int   Int   `xml:"int"`
+	Attr  Int   `xml:"attr,attr"`
+	Float Float `xml:"float"`
+	Bool  Bool  `xml:"bool"`
+}
+
+func TestLenient_UnmarshalText(t *testing.T) {
+	t.Run("decodes the trimmed values", func(t *testing.T) {
+		var values lenientValues
+
+		assert.NoError(t, xml.Unmarshal([]byte(`<values attr=" 3 "><int> 12 </int><float>
+	4.5
+</float><bool><![CDATA[true]]></bool></values>`), &values))
+		assert.Equal(t, lenientValues{Int: 12, Attr: 3, Float: 4.5, Bool: true}, values)
+	})
+
+	t.Run("leaves the empty and blank values to zero", func(t *testing.T) {
+		for _, content := range []string{
+			`<values attr=""><int></int><float/><bool></bool></values>`,
+			`<values attr=" "><int> </int><float><![CDATA[ ]]></float><bool>
+</bool></values>`,
+		} {
+			var values lenientValues
+
+			assert.NoError(t, xml.Unmarshal([]byte(content), &values))
+			assert.Equal(t, lenientValues{}, values)
+		}
+	})
+
+	t.Run("returns an error for a value which is not a number", func(t *testing.T) {
+		var values lenientValues
+
+		assert.EqualError(t, xml.Unmarshal([]byte(`<values><int>many</int></values>`), &values), "strconv.Atoi: parsing \"many\": invalid syntax")
+		assert.EqualError(t, xml.Unmarshal([]byte(`<values><float>high</float></values>`), &values), "strconv.ParseFloat: parsing \"high\": invalid syntax")
+		assert.EqualError(t, xml.Unmarshal([]byte(`<values><bool>maybe</bool></values>`), &values), "strconv.ParseBool: parsing \"maybe\": invalid syntax")
+	})
+}
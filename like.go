@@ -0,0 +1,44 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type likeResponse struct {
+	Like Like `xml:"rating"`
+}
+
+// LikeResource likes a resource, the resource type is in camel case, e.g. "Review", "UserStatus"
+func (c client) LikeResource(ctx context.Context, resourceType string, resourceID Int) (Like, error) {
+	var response = likeResponse{}
+
+	form := url.Values{}
+	form.Set("rating[rating]", "1")
+	form.Set("rating[resource_type]", resourceType)
+	form.Set("rating[resource_id]", strconv.Itoa(int(resourceID)))
+
+	err := c.Post(ctx, "/rating", form, &response)
+
+	if err != nil {
+		return Like{}, fmt.Errorf("failed to like the %s #%d: %w", resourceType, resourceID, err)
+	}
+
+	return response.Like, nil
+}
+
+// UnlikeResource removes a like, it takes the id of the like not the resource one
+func (c client) UnlikeResource(ctx context.Context, likeID Int) error {
+	q := url.Values{}
+	q.Set("id", strconv.Itoa(int(likeID)))
+
+	err := c.Delete(ctx, "/rating", q, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete the like #%d: %w", likeID, err)
+	}
+
+	return nil
+}
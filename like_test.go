@@ -0,0 +1,104 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_LikeResource(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts the rating and returns the like", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "//rating", r.URL.Path)
+			assert.Equal(t, "1", r.PostFormValue("rating[rating]"))
+			assert.Equal(t, "Review", r.PostFormValue("rating[resource_type]"))
+			assert.Equal(t, "3309241775", r.PostFormValue("rating[resource_id]"))
+
+			content, _ := ioutil.ReadFile("fixtures/like_resource.xml")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		like, err := client.LikeResource(ctx, "Review", 3309241775)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Like{
+			ID:           58102273,
+			ResourceType: "Review",
+			ResourceID:   3309241775,
+			UserID:       1,
+			CreatedAt:    "2020-05-13T09:12:00-07:00",
+		}, like)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		like, err := client.LikeResource(ctx, "Review", 3309241775)
+
+		assert.EqualError(t, err, "failed to like the Review #3309241775: request failed for '//rating': 401 Unauthorized")
+		assert.Equal(t, Like{}, like)
+	})
+}
+
+func TestClient_UnlikeResource(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("deletes the like", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			assert.Equal(t, "//rating", r.URL.Path)
+			assert.Equal(t, "58102273", r.URL.Query().Get("id"))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		assert.NoError(t, client.UnlikeResource(ctx, 58102273))
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.UnlikeResource(ctx, 58102273)
+
+		assert.EqualError(t, err, "failed to delete the like #58102273: request failed for '//rating': 404 Not Found")
+	})
+}
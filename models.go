@@ -1,20 +1,53 @@
 package goodreads
 
+import "time"
+
 // SeriesWithWorks include the series and its works
+// Goodreads doesn't always send the pagination of the works, it's then left to 0
 type SeriesWithWorks struct {
 	Series
-	Works []Work `xml:"series>series_works>series_work>work"`
+	Works      []SeriesWork `xml:"series>series_works>series_work"`
+	Pagination Pagination   `xml:"-"`
+}
+
+// ReadingOrder a series a book is part of, with the works of the whole series in reading order
+// Current is the index in Works of the book's work, -1 if Goodreads doesn't list it in the series
+type ReadingOrder struct {
+	SeriesWithWorks
+	Current int
+}
+
+// Bibliography the works of an author, each work once whatever the number of editions
+// The works written with other authors are apart in CoAuthored
+// Truncated is true when the books of the author didn't fit in maxPages pages
+type Bibliography struct {
+	Author     Author
+	Works      []BibliographyWork
+	CoAuthored []BibliographyWork
+	Truncated  bool
+}
+
+// BibliographyWork a work of a bibliography with all its editions
+// Book is the canonical edition, PublicationDate the earliest known publication of the work
+type BibliographyWork struct {
+	WorkID          Int
+	Book            Book
+	Editions        []Book
+	PublicationDate PartialDate
+	CoAuthors       []Author
 }
 
 // Series describe a series
+// The fields are read from the element around <series>, Raw is the content of <series>
 type Series struct {
-	ID               int    `xml:"series>id"`
+	ID               Int    `xml:"series>id" goodreads:"required"`
 	Title            string `xml:"series>title"`
 	Description      string `xml:"series>description"`
 	Note             string `xml:"series>note"`
-	SeriesWorksCount int    `xml:"series>series_works_count"`
-	PrimaryWorkCount int    `xml:"series>primary_work_count"`
-	Numbered         bool   `xml:"series>numbered"`
+	SeriesWorksCount Int    `xml:"series>series_works_count"`
+	PrimaryWorkCount Int    `xml:"series>primary_work_count"`
+	Numbered         Bool   `xml:"series>numbered"`
+	Raw              RawXML `xml:"-" goodreads:"raw=series"`
 }
 
 // Work describes a work
@@ -23,65 +56,410 @@ type Series struct {
 // - OriginalPublicationDate (partial data sometimes)
 // - Maybe some more, be careful :)
 type Work struct {
-	WorkID        int    `xml:"id"`
-	BookID        int    `xml:"best_book>id"`
-	BestBookID    int    `xml:"best_book_id"`
-	OriginalTitle string `xml:"original_title"`
-	Title         string `xml:"best_book>title"`
-	ImageURL      string `xml:"best_book>image_url"`
-	SmallImageURL string `xml:"best_book>small_image_url"`
-	Author        Author `xml:"best_book>author"`
-	OriginalPublicationDate
-}
-
-// OriginalPublicationDate holds the year / month / day
-// 0 value means the API don't have data for the field
-type OriginalPublicationDate struct {
-	Year  int `xml:"original_publication_year"`
-	Month int `xml:"original_publication_month"`
-	Day   int `xml:"original_publication_day"`
-}
-
-// PublicationDate same as OriginalPublicationDate but different xml attr :(
-type PublicationDate struct {
-	Year  int `xml:"publication_year"`
-	Month int `xml:"publication_month"`
-	Day   int `xml:"publication_day"`
+	WorkID                  Int                `xml:"id" goodreads:"required"`
+	BookID                  Int                `xml:"best_book>id"`
+	BestBookID              Int                `xml:"best_book_id"`
+	OriginalTitle           string             `xml:"original_title"`
+	Title                   string             `xml:"best_book>title"`
+	ImageURL                string             `xml:"best_book>image_url"`
+	SmallImageURL           string             `xml:"best_book>small_image_url"`
+	Author                  Author             `xml:"best_book>author"`
+	BooksCount              Int                `xml:"books_count"`
+	ReviewsCount            Int                `xml:"reviews_count"`
+	RatingsSum              Int                `xml:"ratings_sum"`
+	RatingsCount            Int                `xml:"ratings_count"`
+	TextReviewsCount        Int                `xml:"text_reviews_count"`
+	MediaType               string             `xml:"media_type"`
+	OriginalLanguageID      NullInt            `xml:"original_language_id"`
+	DefaultChapteringBookID NullInt            `xml:"default_chaptering_book_id"`
+	RatingDist              RatingDistribution `xml:"rating_dist"`
+	OriginalPublicationDate PartialDate        `xml:"-"`
+	Raw                     RawXML             `xml:"-" goodreads:"raw"`
 }
 
 // AuthorWithBooks include a partial author and his books
 type AuthorWithBooks struct {
 	Author
-	Books []Book `xml:"books>book"`
+	Books      []Book     `xml:"books>book"`
+	Pagination Pagination `xml:"-"`
 }
 
 // Author the guy who wrote the thing
+// BornDate and DiedAt are zero when Goodreads doesn't send them or sends a date which can't be parsed
 type Author struct {
-	ID            int    `xml:"id"`
-	Name          string `xml:"name"`
-	About         string `xml:"about"`
-	ImageURL      string `xml:"image_url"`
-	SmallImageURL string `xml:"small_image_url"`
-	LargeImageURL string `xml:"large_image_url"`
-	WorkCount     int    `xml:"works_count"`
-	Gender        string `xml:"gender"`
-	Hometown      string `xml:"hometown"`
-	BornDate      string `xml:"born_at"`
-	DiedAt        string `xml:"died_at"`
+	ID            Int         `xml:"id" goodreads:"required"`
+	Name          string      `xml:"name"`
+	Role          string      `xml:"role"`
+	About         string      `xml:"about"`
+	ImageURL      string      `xml:"image_url"`
+	SmallImageURL string      `xml:"small_image_url"`
+	LargeImageURL string      `xml:"large_image_url"`
+	WorkCount     Int         `xml:"works_count"`
+	Gender        string      `xml:"gender"`
+	Hometown      string      `xml:"hometown"`
+	BornDate      PartialDate `xml:"born_at"`
+	DiedAt        PartialDate `xml:"died_at"`
+	Raw           RawXML      `xml:"-" goodreads:"raw"`
 }
 
 // Book the paper thing u know
 type Book struct {
-	ID                 int      `xml:"id"`
-	Title              string   `xml:"title"`
-	Description        string   `xml:"description"`
-	ImageURL           string   `xml:"image_url"`
-	SmallImageURL      string   `xml:"small_image_url"`
-	NumPage            int      `xml:"num_pages"`
-	Format             string   `xml:"format"`
-	EditionInformation string   `xml:"edition_information"`
-	Publisher          string   `xml:"publisher"`
-	Work               Work     `xml:"work"`
-	Authors            []Author `xml:"authors>author"`
-	PublicationDate
+	ID                 Int          `xml:"id" goodreads:"required"`
+	Title              string       `xml:"title"`
+	Description        string       `xml:"description"`
+	ImageURL           string       `xml:"image_url"`
+	SmallImageURL      string       `xml:"small_image_url"`
+	NumPage            Int          `xml:"num_pages"`
+	Format             string       `xml:"format"`
+	EditionInformation string       `xml:"edition_information"`
+	Publisher          string       `xml:"publisher"`
+	ISBN               string       `xml:"isbn"`
+	ISBN13             string       `xml:"isbn13"`
+	ASIN               string       `xml:"asin"`
+	KindleASIN         string       `xml:"kindle_asin"`
+	CountryCode        string       `xml:"country_code"`
+	LanguageCode       string       `xml:"language_code"`
+	IsEbook            Bool         `xml:"is_ebook"`
+	AverageRating      Float        `xml:"average_rating"`
+	RatingsCount       Int          `xml:"ratings_count"`
+	TextReviewsCount   Int          `xml:"text_reviews_count"`
+	URL                string       `xml:"url"`
+	Link               string       `xml:"link"`
+	Work               Work         `xml:"work"`
+	Authors            []Author     `xml:"authors>author"`
+	SimilarBooks       []Book       `xml:"similar_books>book"`
+	PopularShelves     []ShelfCount `xml:"popular_shelves>shelf"`
+	BookLinks          []Link       `xml:"book_links>book_link"`
+	BuyLinks           []Link       `xml:"buy_links>buy_link"`
+	SeriesWorks        []SeriesWork `xml:"series_works>series_work"`
+	PublicationDate    PartialDate  `xml:"-"`
+	Raw                RawXML       `xml:"-" goodreads:"raw"`
+}
+
+// ShelfCount how many users put a book in a shelf
+type ShelfCount struct {
+	Name  string `xml:"name,attr"`
+	Count Int    `xml:"count,attr"`
+}
+
+// Link where to find or buy a book, URL redirects to the actual store
+type Link struct {
+	ID   Int    `xml:"id"`
+	Name string `xml:"name"`
+	URL  string `xml:"link"`
+}
+
+// SeriesWork a work as part of a series, with its position in it
+// Series is only set when the work comes from a book (nil otherwise, the series is already known)
+// and Work only when it comes from a series
+type SeriesWork struct {
+	ID       Int            `xml:"id"`
+	Position SeriesPosition `xml:"user_position"`
+	*Series
+	Work Work `xml:"work"`
+}
+
+// Shelf a user's bookshelf, one of the defaults (read, to-read...) or a custom one
+type Shelf struct {
+	ID          Int    `xml:"id"`
+	Name        string `xml:"name"`
+	BookCount   Int    `xml:"book_count"`
+	Description string `xml:"description"`
+	Exclusive   Bool   `xml:"exclusive_flag"`
+	Featured    Bool   `xml:"featured"`
+	Sortable    Bool   `xml:"sortable_flag"`
+}
+
+// OwnedBook a physical copy of a book owned by a user
+// OriginalPurchaseDate and OriginalPurchaseLocation are the ones set with OwnedBookOptions,
+// PurchaseDate is only sent by goodreads, the API has no way to set it
+type OwnedBook struct {
+	ID                       Int    `xml:"id"`
+	Condition                string `xml:"condition"`
+	ConditionCode            Int    `xml:"condition_code"`
+	ConditionDescription     string `xml:"condition_description"`
+	PurchaseDate             string `xml:"purchase_date"`
+	OriginalPurchaseDate     string `xml:"original_purchase_date"`
+	OriginalPurchaseLocation string `xml:"original_purchase_location"`
+	BCID                     string `xml:"unique_code"`
+	Book                     Book   `xml:"book"`
+}
+
+// User a goodreads member, some fields are only there when getting the user itself
+type User struct {
+	ID              Int      `xml:"id" goodreads:"required"`
+	Name            string   `xml:"name"`
+	UserName        string   `xml:"user_name"`
+	Link            string   `xml:"link"`
+	ImageURL        string   `xml:"image_url"`
+	SmallImageURL   string   `xml:"small_image_url"`
+	About           string   `xml:"about"`
+	Age             Int      `xml:"age"`
+	Gender          string   `xml:"gender"`
+	Location        string   `xml:"location"`
+	Website         string   `xml:"website"`
+	Joined          string   `xml:"joined"`
+	LastActive      string   `xml:"last_active"`
+	Interests       string   `xml:"interests"`
+	FavoriteGenres  []string `xml:"-"`
+	FavoriteAuthors []Author `xml:"favorite_authors>author"`
+	FriendsCount    Int      `xml:"friends_count"`
+	GroupsCount     Int      `xml:"groups_count"`
+	ReviewsCount    Int      `xml:"reviews_count"`
+	Shelves         []Shelf  `xml:"user_shelves>user_shelf"`
+}
+
+// Comparison the books shared by the authenticated user and another member
+// Compatibility goes from 0 to 1, see CompareBooks
+type Comparison struct {
+	YourLibraryPercent   Float          `xml:"your_library_percent"`
+	TheirLibraryPercent  Float          `xml:"their_library_percent"`
+	YourTotalBooksCount  Int            `xml:"your_total_books_count"`
+	TheirTotalBooksCount Int            `xml:"their_total_books_count"`
+	CommonCount          Int            `xml:"common_count"`
+	Books                []ComparedBook `xml:"reviews>review"`
+	Compatibility        float64        `xml:"-"`
+}
+
+// ComparedBook a book both users have with their ratings, 0 means not rated
+type ComparedBook struct {
+	Book        Book `xml:"book"`
+	YourRating  Int  `xml:"your_review>rating"`
+	TheirRating Int  `xml:"their_review>rating"`
+}
+
+// Pagination where the current page is in the whole list
+type Pagination struct {
+	Start Int `xml:"start,attr"`
+	End   Int `xml:"end,attr"`
+	Total Int `xml:"total,attr"`
+}
+
+// HasNextPage tells if there's more results after this page
+func (p Pagination) HasNextPage() bool {
+	return p.End < p.Total
+}
+
+// SearchResult a page of works matching a search
+// Total is the number of results for the whole search, not only this page
+type SearchResult struct {
+	Pagination
+	Query            string
+	QueryTimeSeconds float64
+	Works            []Work
+}
+
+// UserList a page of users (friends, followers...)
+type UserList struct {
+	Pagination
+	Users []User `xml:"user"`
+}
+
+// FriendRequest a pending request from another member to become friends
+type FriendRequest struct {
+	ID        Int    `xml:"id"`
+	CreatedAt string `xml:"created_at"`
+	Message   string `xml:"message"`
+	FromUser  User   `xml:"from_user"`
+}
+
+// FriendRequests a page of friend requests
+type FriendRequests struct {
+	Pagination
+	Requests []FriendRequest `xml:"friend_request"`
+}
+
+// AuthorFollowing a user following an author
+type AuthorFollowing struct {
+	ID        Int    `xml:"id"`
+	CreatedAt string `xml:"created_at"`
+	UpdatedAt string `xml:"updated_at"`
+	Author    Author `xml:"author"`
+	User      User   `xml:"user"`
+}
+
+// Group a goodreads group, moderators and books are only there when getting the group itself
+type Group struct {
+	ID               Int    `xml:"id"`
+	Title            string `xml:"title"`
+	Access           string `xml:"access"`
+	Location         string `xml:"location"`
+	MembersCount     Int    `xml:"users_count"`
+	Description      string `xml:"description"`
+	ImageURL         string `xml:"image_url"`
+	Category         string `xml:"category"`
+	Subcategory      string `xml:"subcategory"`
+	LastActivityAt   string `xml:"last_activity_at"`
+	Moderators       []User `xml:"moderators>moderator>user"`
+	CurrentlyReading []Book `xml:"currently_reading>group_book>book"`
+}
+
+// GroupList a page of groups
+type GroupList struct {
+	Pagination
+	Groups []Group `xml:"group"`
+}
+
+// GroupMember a user in a group
+type GroupMember struct {
+	User          User   `xml:"user"`
+	Title         string `xml:"title"`
+	CommentsCount Int    `xml:"comments_count"`
+	JoinedAt      string `xml:"created_at"`
+}
+
+// GroupMembers a page of members of a group
+type GroupMembers struct {
+	Pagination
+	Members []GroupMember `xml:"group_user"`
+}
+
+// Topic a discussion in a group or about a book, comments are only there when getting the topic itself
+type Topic struct {
+	ID            Int         `xml:"id"`
+	Title         string      `xml:"title"`
+	SubjectType   string      `xml:"subject_type"`
+	SubjectID     Int         `xml:"subject_id"`
+	FolderID      Int         `xml:"folder>id"`
+	FolderName    string      `xml:"folder>name"`
+	CommentsCount Int         `xml:"comments_count"`
+	Author        User        `xml:"author"`
+	CreatedAt     string      `xml:"created_at"`
+	UpdatedAt     string      `xml:"updated_at"`
+	LastCommentAt string      `xml:"last_comment_at"`
+	Comments      CommentList `xml:"comments"`
+}
+
+// TopicList a page of topics
+type TopicList struct {
+	Pagination
+	Topics []Topic `xml:"topic"`
+}
+
+// Comment a comment on any resource, the body is HTML
+type Comment struct {
+	ID        Int    `xml:"id"`
+	Body      string `xml:"body"`
+	User      User   `xml:"user"`
+	CreatedAt string `xml:"created_at"`
+	UpdatedAt string `xml:"updated_at"`
+}
+
+// CommentList a page of comments
+type CommentList struct {
+	Pagination
+	Comments []Comment `xml:"comment"`
+}
+
+// UserStatus an update of a user about the book they are reading
+// Page and Percent are 0 when not given
+type UserStatus struct {
+	ID            Int    `xml:"id"`
+	Body          string `xml:"body"`
+	Page          Int    `xml:"page"`
+	Percent       Int    `xml:"percent"`
+	CommentsCount Int    `xml:"comments_count"`
+	LikesCount    Int    `xml:"likes_count"`
+	CreatedAt     string `xml:"created_at"`
+	UpdatedAt     string `xml:"updated_at"`
+	User          User   `xml:"user"`
+	Book          Book   `xml:"book"`
+}
+
+// ReadStatus a change of exclusive shelf (to-read, currently-reading, read) for a book
+type ReadStatus struct {
+	ID        Int    `xml:"id"`
+	Status    string `xml:"status"`
+	OldStatus string `xml:"old_status"`
+	UpdatedAt string `xml:"updated_at"`
+	User      User   `xml:"user"`
+	Book      Book   `xml:"review>book"`
+}
+
+// Review a rating and review of a book by a user, the body is HTML
+type Review struct {
+	ID        Int    `xml:"id"`
+	Rating    Int    `xml:"rating"`
+	Body      string `xml:"body"`
+	CreatedAt string `xml:"created_at"`
+	UpdatedAt string `xml:"updated_at"`
+	User      User   `xml:"user"`
+	Book      Book   `xml:"book"`
+}
+
+// Update an entry of the activity feed, only the field matching the type is set:
+// - UpdateReview: Review
+// - UpdateUserStatus: UserStatus
+// - UpdateReadStatus: ReadStatus (a book added to a shelf)
+// - UpdateFollow: Followed
+// New is only used by the notifications
+type Update struct {
+	Type       UpdateType  `xml:"type,attr"`
+	ActionText string      `xml:"action_text"`
+	Link       string      `xml:"link"`
+	ImageURL   string      `xml:"image_url"`
+	Actor      User        `xml:"actor"`
+	UpdatedAt  string      `xml:"updated_at"`
+	New        bool        `xml:"-"`
+	Review     *Review     `xml:"object>review"`
+	UserStatus *UserStatus `xml:"object>user_status"`
+	ReadStatus *ReadStatus `xml:"object>read_status"`
+	Followed   *User       `xml:"object>user"`
+}
+
+// Quote a quote from a book
+type Quote struct {
+	ID         Int      `xml:"id"`
+	Body       string   `xml:"body"`
+	AuthorID   Int      `xml:"author_id"`
+	AuthorName string   `xml:"author_name"`
+	BookID     Int      `xml:"book_id"`
+	LikesCount Int      `xml:"likes_count"`
+	Tags       []string `xml:"tags>tag"`
+}
+
+// Like a user liking a resource (review, user status...)
+type Like struct {
+	ID           Int    `xml:"id"`
+	ResourceType string `xml:"resource_type"`
+	ResourceID   Int    `xml:"resource_id"`
+	UserID       Int    `xml:"user_id"`
+	CreatedAt    string `xml:"created_at"`
+}
+
+// Recommendation a book recommended by a user to another one
+type Recommendation struct {
+	ID        Int    `xml:"id"`
+	Message   string `xml:"message"`
+	CreatedAt string `xml:"created_at"`
+	FromUser  User   `xml:"from_user"`
+	ToUser    User   `xml:"to_user"`
+	Book      Book   `xml:"book"`
+}
+
+// Event a reading event (signing, book club...) happening somewhere
+// StartAt and EndAt are zero when Goodreads doesn't send them
+type Event struct {
+	ID             Int       `xml:"id"`
+	Title          string    `xml:"title"`
+	Description    string    `xml:"description"`
+	EventType      string    `xml:"event_type"`
+	Access         string    `xml:"access"`
+	Venue          string    `xml:"venue"`
+	Address        string    `xml:"address"`
+	City           string    `xml:"city"`
+	State          string    `xml:"state"`
+	CountryCode    string    `xml:"country_code"`
+	PostalCode     string    `xml:"postal_code"`
+	Latitude       Float     `xml:"latitude"`
+	Longitude      Float     `xml:"longitude"`
+	StartAt        time.Time `xml:"-"`
+	EndAt          time.Time `xml:"-"`
+	Link           string    `xml:"link"`
+	ImageURL       string    `xml:"image_url"`
+	ResourceType   string    `xml:"resource_type"`
+	ResourceID     Int       `xml:"resource_id"`
+	ResourceURL    string    `xml:"resource_url"`
+	AttendingCount Int       `xml:"attending_count"`
+	ResponsesCount Int       `xml:"event_responses_count"`
 }
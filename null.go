@@ -0,0 +1,159 @@
+package goodreads
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+)
+
+// Goodreads sends the missing values as nil="true" elements or as empty elements,
+// the Null* types tell them apart from the zero values:
+// - Present is false when the element wasn't in the response
+// - Valid is false when the element was there without a value (nil="true" or empty)
+// - otherwise the value is set, even if it's 0
+
+// NullInt an int which may be missing or null
+type NullInt struct {
+	Int     int
+	Valid   bool
+	Present bool
+}
+
+// NullFloat a float which may be missing or null
+type NullFloat struct {
+	Float   float64
+	Valid   bool
+	Present bool
+}
+
+// NullBool a bool which may be missing or null
+type NullBool struct {
+	Bool    bool
+	Valid   bool
+	Present bool
+}
+
+// IsNull tells if the element was sent without a value
+func (n NullInt) IsNull() bool {
+	return n.Present && !n.Valid
+}
+
+// IsNull tells if the element was sent without a value
+func (n NullFloat) IsNull() bool {
+	return n.Present && !n.Valid
+}
+
+// IsNull tells if the element was sent without a value
+func (n NullBool) IsNull() bool {
+	return n.Present && !n.Valid
+}
+
+// UnmarshalXML decodes the value, nil="true" and empty elements are null
+func (n *NullInt) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	value, err := decodeNullable(d, start)
+
+	if err != nil {
+		return err
+	}
+
+	*n = NullInt{Present: true}
+
+	if value == "" {
+		return nil
+	}
+
+	if n.Int, err = strconv.Atoi(value); err != nil {
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
+
+// UnmarshalXML decodes the value, nil="true" and empty elements are null
+func (n *NullFloat) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	value, err := decodeNullable(d, start)
+
+	if err != nil {
+		return err
+	}
+
+	*n = NullFloat{Present: true}
+
+	if value == "" {
+		return nil
+	}
+
+	if n.Float, err = strconv.ParseFloat(value, 64); err != nil {
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
+
+// UnmarshalXML decodes the value, nil="true" and empty elements are null
+func (n *NullBool) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	value, err := decodeNullable(d, start)
+
+	if err != nil {
+		return err
+	}
+
+	*n = NullBool{Present: true}
+
+	if value == "" {
+		return nil
+	}
+
+	if n.Bool, err = strconv.ParseBool(value); err != nil {
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
+
+// decodeNullable returns the trimmed text of the element, empty if it's nil="true"
+func decodeNullable(d *xml.Decoder, start xml.StartElement) (string, error) {
+	var value string
+
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return "", err
+	}
+
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "nil" && attr.Value == "true" {
+			return "", nil
+		}
+	}
+
+	return strings.TrimSpace(value), nil
+}
+
+func parseOptionalBool(value string) (bool, error) {
+	if value = strings.TrimSpace(value); value == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(value)
+}
+
+func parseOptionalFloat(value string) (float64, error) {
+	if value = strings.TrimSpace(value); value == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseFloat(value, 64)
+}
+
+func parseOptionalInt(value string) (int, error) {
+	if value = strings.TrimSpace(value); value == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(value)
+}
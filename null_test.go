@@ -0,0 +1,73 @@
+package goodreads
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type nullValues struct {
+	Int   NullInt   `xml:"int"`
+	Float NullFloat `xml:"float"`
+	Bool  NullBool  `xml:"bool"`
+}
+
+func TestNull_UnmarshalXML(t *testing.T) {
+	t.Run("leaves the missing values not present", func(t *testing.T) {
+		var values nullValues
+
+		assert.NoError(t, xml.Unmarshal([]byte("<values></values>"), &values))
+		assert.Equal(t, nullValues{}, values)
+		assert.False(t, values.Int.IsNull())
+	})
+
+	t.Run("decodes the nil and empty values as null", func(t *testing.T) {
+		for _, content := range []string{
+			`<values><int nil="true"/><float type="float" nil="true"/><bool nil="true">true</bool></values>`,
+			`<values><int></int><float><![CDATA[ ]]></float><bool/></values>`,
+		} {
+			var values nullValues
+
+			assert.NoError(t, xml.Unmarshal([]byte(content), &values))
+			assert.Equal(t, nullValues{
+				Int:   NullInt{Present: true},
+				Float: NullFloat{Present: true},
+				Bool:  NullBool{Present: true},
+			}, values)
+			assert.True(t, values.Int.IsNull())
+			assert.True(t, values.Float.IsNull())
+			assert.True(t, values.Bool.IsNull())
+		}
+	})
+
+	t.Run("decodes the zero values as valid", func(t *testing.T) {
+		var values nullValues
+
+		assert.NoError(t, xml.Unmarshal([]byte(`<values><int>0</int><float>0.0</float><bool>false</bool></values>`), &values))
+		assert.Equal(t, nullValues{
+			Int:   NullInt{Valid: true, Present: true},
+			Float: NullFloat{Valid: true, Present: true},
+			Bool:  NullBool{Valid: true, Present: true},
+		}, values)
+	})
+
+	t.Run("decodes the values", func(t *testing.T) {
+		var values nullValues
+
+		assert.NoError(t, xml.Unmarshal([]byte(`<values><int type="integer"> 42 </int><float><![CDATA[4.74]]></float><bool>true</bool></values>`), &values))
+		assert.Equal(t, nullValues{
+			Int:   NullInt{Int: 42, Valid: true, Present: true},
+			Float: NullFloat{Float: 4.74, Valid: true, Present: true},
+			Bool:  NullBool{Bool: true, Valid: true, Present: true},
+		}, values)
+	})
+
+	t.Run("returns an error for a malformed value", func(t *testing.T) {
+		var values nullValues
+
+		assert.EqualError(t, xml.Unmarshal([]byte(`<values><int>many</int></values>`), &values), "strconv.Atoi: parsing \"many\": invalid syntax")
+		assert.EqualError(t, xml.Unmarshal([]byte(`<values><float>high</float></values>`), &values), "strconv.ParseFloat: parsing \"high\": invalid syntax")
+		assert.EqualError(t, xml.Unmarshal([]byte(`<values><bool>maybe</bool></values>`), &values), "strconv.ParseBool: parsing \"maybe\": invalid syntax")
+	})
+}
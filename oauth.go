@@ -0,0 +1,145 @@
+package goodreads
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// oauthTransport signs every request going through it with OAuth 1.0a (HMAC-SHA1)
+// Goodreads needs it for all the endpoints acting on behalf of a user
+type oauthTransport struct {
+	consumerKey    string
+	consumerSecret string
+	token          string
+	tokenSecret    string
+	base           http.RoundTripper
+	nonce          func() string
+	now            func() time.Time
+}
+
+// RoundTrip signs a copy of the request and sends it with the base transport
+func (t *oauthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	signed := req.Clone(req.Context())
+
+	params := url.Values{}
+
+	for key, values := range signed.URL.Query() {
+		params[key] = append(params[key], values...)
+	}
+
+	if signed.Body != nil && signed.Header.Get("Content-Type") == "application/x-www-form-urlencoded" {
+		body, err := ioutil.ReadAll(signed.Body)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read the body to sign: %w", err)
+		}
+
+		_ = signed.Body.Close()
+		signed.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		form, err := url.ParseQuery(string(body))
+
+		if err != nil {
+			return nil, fmt.Errorf("could not parse the body to sign: %w", err)
+		}
+
+		for key, values := range form {
+			params[key] = append(params[key], values...)
+		}
+	}
+
+	oauthParams := map[string]string{
+		"oauth_consumer_key":     t.consumerKey,
+		"oauth_nonce":            t.nonce(),
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(t.now().Unix(), 10),
+		"oauth_token":            t.token,
+		"oauth_version":          "1.0",
+	}
+
+	for key, value := range oauthParams {
+		params.Set(key, value)
+	}
+
+	oauthParams["oauth_signature"] = t.signature(signed.Method, signed.URL, params)
+
+	keys := make([]string, 0, len(oauthParams))
+
+	for key := range oauthParams {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	header := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		header = append(header, fmt.Sprintf(`%s="%s"`, percentEncode(key), percentEncode(oauthParams[key])))
+	}
+
+	signed.Header.Set("Authorization", "OAuth "+strings.Join(header, ", "))
+
+	return t.base.RoundTrip(signed)
+}
+
+// signature computes the HMAC-SHA1 signature of the request as described in
+// https://tools.ietf.org/html/rfc5849#section-3.4
+func (t *oauthTransport) signature(method string, u *url.URL, params url.Values) string {
+	pairs := []string{}
+
+	for key, values := range params {
+		for _, value := range values {
+			pairs = append(pairs, percentEncode(key)+"="+percentEncode(value))
+		}
+	}
+
+	sort.Strings(pairs)
+
+	baseURL := fmt.Sprintf("%s://%s%s", strings.ToLower(u.Scheme), strings.ToLower(u.Host), u.EscapedPath())
+	baseString := strings.Join([]string{
+		strings.ToUpper(method),
+		percentEncode(baseURL),
+		percentEncode(strings.Join(pairs, "&")),
+	}, "&")
+
+	mac := hmac.New(sha1.New, []byte(percentEncode(t.consumerSecret)+"&"+percentEncode(t.tokenSecret)))
+	_, _ = mac.Write([]byte(baseString))
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// percentEncode escapes everything except the unreserved characters (RFC 3986)
+// url.QueryEscape is not enough, it turns spaces into '+'
+func percentEncode(s string) string {
+	var b strings.Builder
+
+	for _, c := range []byte(s) {
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			_, _ = fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
+
+func randomNonce() string {
+	nonce := make([]byte, 16)
+	_, _ = rand.Read(nonce)
+
+	return hex.EncodeToString(nonce)
+}
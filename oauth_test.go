@@ -0,0 +1,78 @@
+package goodreads
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOAuthTransport_signature(t *testing.T) {
+	t.Run("it signs the request like the spec example", func(t *testing.T) {
+		// https://developer.twitter.com/en/docs/authentication/oauth-1-0a/creating-a-signature
+		transport := oauthTransport{
+			consumerSecret: "kAcSOqF21Fu85e7zjz7ZN2U4ZRhfV3WpwPAoE3Z7kBw",
+			tokenSecret:    "LswwdoUaIvS8ltyTt5jkRh4J50vUPVVHtR2YPi5kE",
+		}
+		u, _ := url.Parse("https://api.twitter.com/1.1/statuses/update.json?include_entities=true")
+		params := url.Values{
+			"include_entities":       {"true"},
+			"status":                 {"Hello Ladies + Gentlemen, a signed OAuth request!"},
+			"oauth_consumer_key":     {"xvz1evFS4wEEPTGEFPHBog"},
+			"oauth_nonce":            {"kYjzVBB8Y0ZFabxSWbWovY3uYSQ2pTgmZeNu2VS4cg"},
+			"oauth_signature_method": {"HMAC-SHA1"},
+			"oauth_timestamp":        {"1318622958"},
+			"oauth_token":            {"370773112-GmHxMAgYyLbNEtIKZeRNFsMKPR9EyMZeS9weJAEb"},
+			"oauth_version":          {"1.0"},
+		}
+
+		assert.Equal(t, "hCtSmYh+iHYCEqBWrE7C7hYmtUk=", transport.signature("POST", u, params))
+	})
+}
+
+func TestOAuthTransport_RoundTrip(t *testing.T) {
+	t.Run("it adds the oauth authorization header and keeps the body", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization := r.Header.Get("Authorization")
+
+			assert.True(t, strings.HasPrefix(authorization, "OAuth "))
+			assert.Contains(t, authorization, `oauth_consumer_key="key"`)
+			assert.Contains(t, authorization, `oauth_nonce="nonce"`)
+			assert.Contains(t, authorization, `oauth_timestamp="1318622958"`)
+			assert.Contains(t, authorization, `oauth_token="token"`)
+			assert.Contains(t, authorization, `oauth_signature="`)
+			assert.Equal(t, "to-read", r.PostFormValue("name"))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "key",
+			domain: ts.URL,
+			http: &http.Client{
+				Transport: &oauthTransport{
+					consumerKey:    "key",
+					consumerSecret: "secret",
+					token:          "token",
+					tokenSecret:    "tokensecret",
+					base:           ts.Client().Transport,
+					nonce:          func() string { return "nonce" },
+					now:            func() time.Time { return time.Unix(1318622958, 0) },
+				},
+			},
+		}
+
+		err := client.Post(context.TODO(), "bar", url.Values{"name": {"to-read"}}, nil)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestPercentEncode(t *testing.T) {
+	assert.Equal(t, "Hello%20Ladies%20%2B%20Gentlemen%2C%20a%20signed%20OAuth%20request%21", percentEncode("Hello Ladies + Gentlemen, a signed OAuth request!"))
+	assert.Equal(t, "-._~", percentEncode("-._~"))
+}
@@ -0,0 +1,134 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// BookCondition the condition codes accepted by goodreads for an owned book
+type BookCondition int
+
+// All the conditions an owned book can be in
+const (
+	ConditionBrandNew   BookCondition = 10
+	ConditionLikeNew    BookCondition = 20
+	ConditionVeryGood   BookCondition = 30
+	ConditionGood       BookCondition = 40
+	ConditionAcceptable BookCondition = 50
+	ConditionPoor       BookCondition = 60
+)
+
+// OwnedBookOptions the details of an owned book when creating or updating it
+// Dates are formatted as YYYY-MM-DD, empty values are not sent
+type OwnedBookOptions struct {
+	Condition                BookCondition
+	ConditionDescription     string
+	OriginalPurchaseDate     string
+	OriginalPurchaseLocation string
+	BCID                     string
+}
+
+type listOwnedBooksResponse struct {
+	OwnedBooks []OwnedBook `xml:"owned_books>owned_book"`
+}
+
+type ownedBookResponse struct {
+	OwnedBook OwnedBook `xml:"owned_book"`
+}
+
+// ListOwnedBooks returns a paginated list of the books owned by the user
+func (c client) ListOwnedBooks(ctx context.Context, userID Int, page int) ([]OwnedBook, error) {
+	var response = listOwnedBooksResponse{
+		OwnedBooks: []OwnedBook{},
+	}
+
+	q := url.Values{}
+	q.Set("page", strconv.Itoa(page))
+
+	err := c.Get(ctx, fmt.Sprintf("/owned_books/user/%d", userID), q, &response)
+
+	if err != nil {
+		return []OwnedBook{}, fmt.Errorf("failed to get the owned books of the user #%d in page #%d: %w", userID, page, err)
+	}
+
+	return response.OwnedBooks, nil
+}
+
+// GetOwnedBook retrieve a specific owned book
+func (c client) GetOwnedBook(ctx context.Context, ownedBookID Int) (OwnedBook, error) {
+	var response = ownedBookResponse{}
+
+	err := c.Get(ctx, fmt.Sprintf("/owned_books/show/%d", ownedBookID), url.Values{}, &response)
+
+	if err != nil {
+		return OwnedBook{}, fmt.Errorf("failed to get the owned book #%d: %w", ownedBookID, err)
+	}
+
+	return response.OwnedBook, nil
+}
+
+// CreateOwnedBook adds the book to the books owned by the authenticated user
+func (c client) CreateOwnedBook(ctx context.Context, bookID Int, options OwnedBookOptions) (OwnedBook, error) {
+	var response = ownedBookResponse{}
+
+	form := ownedBookForm(options)
+	form.Set("owned_book[book_id]", strconv.Itoa(int(bookID)))
+
+	err := c.Post(ctx, "/owned_books", form, &response)
+
+	if err != nil {
+		return OwnedBook{}, fmt.Errorf("failed to add the book #%d to the owned books: %w", bookID, err)
+	}
+
+	return response.OwnedBook, nil
+}
+
+// UpdateOwnedBook edits the details of an owned book
+func (c client) UpdateOwnedBook(ctx context.Context, ownedBookID Int, options OwnedBookOptions) error {
+	err := c.Put(ctx, fmt.Sprintf("/owned_books/update/%d", ownedBookID), ownedBookForm(options), nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to update the owned book #%d: %w", ownedBookID, err)
+	}
+
+	return nil
+}
+
+// DeleteOwnedBook removes the book from the books owned by the authenticated user
+func (c client) DeleteOwnedBook(ctx context.Context, ownedBookID Int) error {
+	err := c.Post(ctx, fmt.Sprintf("/owned_books/destroy/%d", ownedBookID), url.Values{}, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete the owned book #%d: %w", ownedBookID, err)
+	}
+
+	return nil
+}
+
+func ownedBookForm(options OwnedBookOptions) url.Values {
+	form := url.Values{}
+
+	if options.Condition != 0 {
+		form.Set("owned_book[condition_code]", strconv.Itoa(int(options.Condition)))
+	}
+
+	if options.ConditionDescription != "" {
+		form.Set("owned_book[condition_description]", options.ConditionDescription)
+	}
+
+	if options.OriginalPurchaseDate != "" {
+		form.Set("owned_book[original_purchase_date]", options.OriginalPurchaseDate)
+	}
+
+	if options.OriginalPurchaseLocation != "" {
+		form.Set("owned_book[original_purchase_location]", options.OriginalPurchaseLocation)
+	}
+
+	if options.BCID != "" {
+		form.Set("owned_book[unique_code]", options.BCID)
+	}
+
+	return form
+}
@@ -0,0 +1,285 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var kingsOfTheWyldOwnedBook = OwnedBook{
+	ID:                       9061254,
+	Condition:                "like new",
+	ConditionCode:            20,
+	ConditionDescription:     "small tear on the cover",
+	PurchaseDate:             "2019-03-02",
+	OriginalPurchaseDate:     "2018-11-24",
+	OriginalPurchaseLocation: "Shakespeare and Company",
+	BCID:                     "123-4567890",
+	Book: Book{
+		ID:        30841984,
+		Title:     "Kings of the Wyld (The Band, #1)",
+		NumPage:   502,
+		Format:    "Paperback",
+		Publisher: "Orbit",
+		Work: Work{
+			WorkID: 51246585,
+		},
+		Authors: []Author{
+			{
+				ID:   15388346,
+				Name: "Nicholas Eames",
+			},
+		},
+	},
+}
+
+func TestClient_ListOwnedBooks(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the books owned by the user", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//owned_books/user/42", r.URL.Path)
+			assert.Equal(t, "2", r.URL.Query().Get("page"))
+
+			content, _ := ioutil.ReadFile("fixtures/list_owned_books.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		ownedBooks, err := client.ListOwnedBooks(ctx, 42, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []OwnedBook{
+			kingsOfTheWyldOwnedBook,
+			{
+				ID: 9061255,
+				Book: Book{
+					ID:        35052265,
+					Title:     "Bloody Rose (The Band, #2)",
+					NumPage:   544,
+					Format:    "Paperback",
+					Publisher: "Orbit",
+					Work: Work{
+						WorkID: 56340013,
+					},
+					Authors: []Author{
+						{
+							ID:   15388346,
+							Name: "Nicholas Eames",
+						},
+					},
+				},
+			},
+		}, ownedBooks)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		ownedBooks, err := client.ListOwnedBooks(ctx, 42, 2)
+
+		assert.EqualError(t, err, "failed to get the owned books of the user #42 in page #2: request failed for '//owned_books/user/42': 500 Internal Server Error")
+		assert.Equal(t, []OwnedBook{}, ownedBooks)
+	})
+}
+
+func TestClient_GetOwnedBook(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the owned book", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//owned_books/show/9061254", r.URL.Path)
+
+			content, _ := ioutil.ReadFile("fixtures/get_owned_book.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		ownedBook, err := client.GetOwnedBook(ctx, 9061254)
+
+		assert.NoError(t, err)
+		assert.Equal(t, kingsOfTheWyldOwnedBook, ownedBook)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		ownedBook, err := client.GetOwnedBook(ctx, 9061254)
+
+		assert.EqualError(t, err, "failed to get the owned book #9061254: request failed for '//owned_books/show/9061254': 404 Not Found")
+		assert.Equal(t, OwnedBook{}, ownedBook)
+	})
+}
+
+func TestClient_CreateOwnedBook(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts the owned book and returns the created one", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "//owned_books", r.URL.Path)
+			assert.Equal(t, "30841984", r.PostFormValue("owned_book[book_id]"))
+			assert.Equal(t, "20", r.PostFormValue("owned_book[condition_code]"))
+			assert.Equal(t, "small tear on the cover", r.PostFormValue("owned_book[condition_description]"))
+			assert.Equal(t, "2018-11-24", r.PostFormValue("owned_book[original_purchase_date]"))
+			assert.Equal(t, "Shakespeare and Company", r.PostFormValue("owned_book[original_purchase_location]"))
+			assert.Equal(t, "123-4567890", r.PostFormValue("owned_book[unique_code]"))
+
+			content, _ := ioutil.ReadFile("fixtures/get_owned_book.xml")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		ownedBook, err := client.CreateOwnedBook(ctx, 30841984, OwnedBookOptions{
+			Condition:                ConditionLikeNew,
+			ConditionDescription:     "small tear on the cover",
+			OriginalPurchaseDate:     "2018-11-24",
+			OriginalPurchaseLocation: "Shakespeare and Company",
+			BCID:                     "123-4567890",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, kingsOfTheWyldOwnedBook, ownedBook)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		ownedBook, err := client.CreateOwnedBook(ctx, 30841984, OwnedBookOptions{})
+
+		assert.EqualError(t, err, "failed to add the book #30841984 to the owned books: request failed for '//owned_books': 401 Unauthorized")
+		assert.Equal(t, OwnedBook{}, ownedBook)
+	})
+}
+
+func TestClient_UpdateOwnedBook(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("puts only the given details", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "//owned_books/update/9061254", r.URL.Path)
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "50", r.PostForm.Get("owned_book[condition_code]"))
+			assert.NotContains(t, r.PostForm, "owned_book[unique_code]")
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.UpdateOwnedBook(ctx, 9061254, OwnedBookOptions{Condition: ConditionAcceptable})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.UpdateOwnedBook(ctx, 9061254, OwnedBookOptions{})
+
+		assert.EqualError(t, err, "failed to update the owned book #9061254: request failed for '//owned_books/update/9061254': 404 Not Found")
+	})
+}
+
+func TestClient_DeleteOwnedBook(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts to the destroy endpoint", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "//owned_books/destroy/9061254", r.URL.Path)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.DeleteOwnedBook(ctx, 9061254)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.DeleteOwnedBook(ctx, 9061254)
+
+		assert.EqualError(t, err, "failed to delete the owned book #9061254: request failed for '//owned_books/destroy/9061254': 404 Not Found")
+	})
+}
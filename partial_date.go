@@ -0,0 +1,232 @@
+package goodreads
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DatePrecision how much of a PartialDate is known
+type DatePrecision int
+
+// The precisions of a PartialDate, from the least to the most precise
+const (
+	PrecisionNone DatePrecision = iota
+	PrecisionYear
+	PrecisionMonth
+	PrecisionDay
+)
+
+// PartialDate a date Goodreads only knows partially, 0 values mean unknown
+// A month is only meaningful with a year and a day with a month
+type PartialDate struct {
+	Year  int
+	Month int
+	Day   int
+}
+
+// Precision tells up to which part the date is known
+func (d PartialDate) Precision() DatePrecision {
+	switch {
+	case d.Year == 0:
+		return PrecisionNone
+	case d.Month == 0:
+		return PrecisionYear
+	case d.Day == 0:
+		return PrecisionMonth
+	default:
+		return PrecisionDay
+	}
+}
+
+// IsZero tells if nothing is known about the date
+func (d PartialDate) IsZero() bool {
+	return d.Precision() == PrecisionNone
+}
+
+// Time converts the date to a time in UTC, only if the day is known
+func (d PartialDate) Time() (time.Time, bool) {
+	if d.Precision() != PrecisionDay {
+		return time.Time{}, false
+	}
+
+	return time.Date(d.Year, time.Month(d.Month), d.Day, 0, 0, 0, 0, time.UTC), true
+}
+
+// Compare returns -1, 0 or 1 if the date is before, the same as or after the other one
+// The parts are compared up to the precision of the dates, an unknown part comes
+// before a known one: 2017 < 2017-02 < 2017-02-21 < 2018, an unknown date comes first,
+// even before the negative (BC) years
+func (d PartialDate) Compare(other PartialDate) int {
+	a, b := d.known(), other.known()
+
+	for i := range a {
+		switch {
+		case !a[i].known && !b[i].known:
+			return 0
+		case !a[i].known:
+			return -1
+		case !b[i].known:
+			return 1
+		case a[i].value < b[i].value:
+			return -1
+		case a[i].value > b[i].value:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// Before tells if the date comes before the other one, see Compare
+func (d PartialDate) Before(other PartialDate) bool {
+	return d.Compare(other) < 0
+}
+
+type datePart struct {
+	value int
+	known bool
+}
+
+// known the parts of the date, only the ones up to its precision are known
+func (d PartialDate) known() [3]datePart {
+	parts := [3]datePart{{value: d.Year}, {value: d.Month}, {value: d.Day}}
+
+	for i := 0; i < int(d.Precision()); i++ {
+		parts[i].known = true
+	}
+
+	return parts
+}
+
+// String formats the date up to its precision: "2017-02-21", "2017-02", "2017" or ""
+// The years before Christ are negative: "-0700"
+func (d PartialDate) String() string {
+	switch d.Precision() {
+	case PrecisionYear:
+		return formatYear(d.Year)
+	case PrecisionMonth:
+		return fmt.Sprintf("%s-%02d", formatYear(d.Year), d.Month)
+	case PrecisionDay:
+		return fmt.Sprintf("%s-%02d-%02d", formatYear(d.Year), d.Month, d.Day)
+	default:
+		return ""
+	}
+}
+
+func formatYear(year int) string {
+	if year < 0 {
+		return fmt.Sprintf("-%04d", -year)
+	}
+
+	return fmt.Sprintf("%04d", year)
+}
+
+// MarshalText formats the date, see String
+func (d PartialDate) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses "2017-02-21", "2017-02" or "2017", with '-' or '/' separators
+// like the Goodreads author dates ("1965/07/31"), an empty value gives a zero date
+// A leading '-' is a year before Christ: "-700"
+func (d *PartialDate) UnmarshalText(text []byte) error {
+	*d = PartialDate{}
+
+	value := strings.TrimSpace(string(text))
+
+	if value == "" {
+		return nil
+	}
+
+	unsigned := strings.TrimPrefix(value, "-")
+	parts := strings.Split(unsigned, "-")
+
+	if len(parts) == 1 {
+		parts = strings.Split(unsigned, "/")
+	}
+
+	if len(parts) > 3 {
+		return fmt.Errorf("invalid date '%s'", value)
+	}
+
+	var numbers [3]int
+
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid date '%s'", value)
+		}
+
+		numbers[i] = n
+	}
+
+	if unsigned != value {
+		numbers[0] = -numbers[0]
+	}
+
+	date := PartialDate{Year: numbers[0], Month: numbers[1], Day: numbers[2]}
+
+	if err := date.validate(); err != nil {
+		return fmt.Errorf("invalid date '%s': %w", value, err)
+	}
+
+	*d = date
+
+	return nil
+}
+
+// UnmarshalXML decodes the element with UnmarshalText, a date which can't be parsed
+// ("c. 1564", "1965/02/30") is left to zero instead of failing the whole response,
+// strict mode reports it
+func (d *PartialDate) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	var value string
+
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+
+	_ = d.UnmarshalText([]byte(value))
+
+	return nil
+}
+
+// validate checks the known parts are a possible date
+func (d PartialDate) validate() error {
+	if d.Month > 12 {
+		return fmt.Errorf("month %d out of range", d.Month)
+	}
+
+	if d.Day == 0 {
+		return nil
+	}
+
+	t := time.Date(d.Year, time.Month(d.Month), d.Day, 0, 0, 0, 0, time.UTC)
+
+	if t.Day() != d.Day {
+		return fmt.Errorf("day %d out of range", d.Day)
+	}
+
+	return nil
+}
+
+// newPartialDate builds the date from the separate year, month and day elements of
+// the books and works, empty values are unknown parts
+func newPartialDate(year, month, day string) (PartialDate, error) {
+	var numbers [3]int
+
+	for i, value := range []string{year, month, day} {
+		n, err := parseOptionalInt(value)
+
+		if err != nil {
+			return PartialDate{}, fmt.Errorf("invalid date part '%s': %w", value, err)
+		}
+
+		numbers[i] = n
+	}
+
+	return PartialDate{Year: numbers[0], Month: numbers[1], Day: numbers[2]}, nil
+}
@@ -0,0 +1,162 @@
+package goodreads
+
+import (
+	"encoding/xml"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartialDate_Precision(t *testing.T) {
+	assert.Equal(t, PrecisionNone, PartialDate{}.Precision())
+	assert.Equal(t, PrecisionNone, PartialDate{Month: 2, Day: 21}.Precision())
+	assert.Equal(t, PrecisionYear, PartialDate{Year: 2017}.Precision())
+	assert.Equal(t, PrecisionYear, PartialDate{Year: 2017, Day: 21}.Precision())
+	assert.Equal(t, PrecisionMonth, PartialDate{Year: 2017, Month: 2}.Precision())
+	assert.Equal(t, PrecisionDay, PartialDate{Year: 2017, Month: 2, Day: 21}.Precision())
+	assert.True(t, PartialDate{}.IsZero())
+	assert.False(t, PartialDate{Year: 2017}.IsZero())
+}
+
+func TestPartialDate_Time(t *testing.T) {
+	t.Run("converts a complete date", func(t *testing.T) {
+		date, ok := PartialDate{Year: 2017, Month: 2, Day: 21}.Time()
+
+		assert.True(t, ok)
+		assert.Equal(t, time.Date(2017, time.February, 21, 0, 0, 0, 0, time.UTC), date)
+	})
+
+	t.Run("does not convert a partial date", func(t *testing.T) {
+		date, ok := PartialDate{Year: 2017, Month: 2}.Time()
+
+		assert.False(t, ok)
+		assert.Equal(t, time.Time{}, date)
+	})
+}
+
+func TestPartialDate_Compare(t *testing.T) {
+	t.Run("respects the precision", func(t *testing.T) {
+		dates := []PartialDate{
+			{Year: 2018},
+			{Year: 2017, Month: 2, Day: 21},
+			{},
+			{Year: 2017, Month: 2},
+			{Year: 2017},
+			{Year: 2017, Month: 1, Day: 30},
+		}
+
+		sort.Slice(dates, func(i, j int) bool {
+			return dates[i].Before(dates[j])
+		})
+
+		assert.Equal(t, []PartialDate{
+			{},
+			{Year: 2017},
+			{Year: 2017, Month: 1, Day: 30},
+			{Year: 2017, Month: 2},
+			{Year: 2017, Month: 2, Day: 21},
+			{Year: 2018},
+		}, dates)
+	})
+
+	t.Run("ignores the parts after the precision", func(t *testing.T) {
+		assert.Equal(t, 0, PartialDate{Year: 2017, Day: 21}.Compare(PartialDate{Year: 2017}))
+		assert.Equal(t, 0, PartialDate{Year: 2017, Month: 2}.Compare(PartialDate{Year: 2017, Month: 2}))
+		assert.Equal(t, 1, PartialDate{Year: 2017, Month: 2}.Compare(PartialDate{Year: 2017, Day: 21}))
+		assert.Equal(t, -1, PartialDate{Month: 2}.Compare(PartialDate{Year: 1}))
+	})
+
+	t.Run("puts the unknown date before the years before Christ", func(t *testing.T) {
+		assert.Equal(t, -1, PartialDate{}.Compare(PartialDate{Year: -700}))
+		assert.Equal(t, 1, PartialDate{Year: -700}.Compare(PartialDate{}))
+		assert.Equal(t, -1, PartialDate{Year: -700}.Compare(PartialDate{Year: -500}))
+		assert.Equal(t, -1, PartialDate{Year: -1}.Compare(PartialDate{Year: 1}))
+	})
+}
+
+func TestPartialDate_String(t *testing.T) {
+	assert.Equal(t, "", PartialDate{}.String())
+	assert.Equal(t, "2017", PartialDate{Year: 2017}.String())
+	assert.Equal(t, "2017-02", PartialDate{Year: 2017, Month: 2}.String())
+	assert.Equal(t, "2017-02-21", PartialDate{Year: 2017, Month: 2, Day: 21}.String())
+	assert.Equal(t, "0850", PartialDate{Year: 850, Day: 3}.String())
+	assert.Equal(t, "-0700", PartialDate{Year: -700}.String())
+	assert.Equal(t, "-0044-03-15", PartialDate{Year: -44, Month: 3, Day: 15}.String())
+
+	text, err := PartialDate{Year: 2017, Month: 2}.MarshalText()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2017-02", string(text))
+}
+
+func TestPartialDate_UnmarshalText(t *testing.T) {
+	t.Run("parses the supported formats", func(t *testing.T) {
+		for text, expected := range map[string]PartialDate{
+			"":           {},
+			"  ":         {},
+			"1965/07/31": {Year: 1965, Month: 7, Day: 31},
+			"1965/07":    {Year: 1965, Month: 7},
+			"1965":       {Year: 1965},
+			"2017-02-21": {Year: 2017, Month: 2, Day: 21},
+			" 2017-02 ":  {Year: 2017, Month: 2},
+			"-700":       {Year: -700},
+			"-0044-03":   {Year: -44, Month: 3},
+		} {
+			var date = PartialDate{Year: 1}
+
+			assert.NoError(t, date.UnmarshalText([]byte(text)), text)
+			assert.Equal(t, expected, date, text)
+		}
+	})
+
+	t.Run("round trips with MarshalText", func(t *testing.T) {
+		for _, expected := range []PartialDate{
+			{Year: 1965, Month: 7},
+			{Year: -700},
+			{Year: -44, Month: 3, Day: 15},
+		} {
+			var date PartialDate
+
+			text, err := expected.MarshalText()
+
+			assert.NoError(t, err)
+			assert.NoError(t, date.UnmarshalText(text))
+			assert.Equal(t, expected, date)
+		}
+	})
+
+	t.Run("returns an error for an invalid date", func(t *testing.T) {
+		var date PartialDate
+
+		assert.EqualError(t, date.UnmarshalText([]byte("July 1965")), "invalid date 'July 1965'")
+		assert.EqualError(t, date.UnmarshalText([]byte("1965/07/31/12")), "invalid date '1965/07/31/12'")
+		assert.EqualError(t, date.UnmarshalText([]byte("1965/13")), "invalid date '1965/13': month 13 out of range")
+		assert.EqualError(t, date.UnmarshalText([]byte("1965/02/30")), "invalid date '1965/02/30': day 30 out of range")
+		assert.EqualError(t, date.UnmarshalText([]byte("1965/00/12")), "invalid date '1965/00/12'")
+		assert.EqualError(t, date.UnmarshalText([]byte("--700")), "invalid date '--700'")
+		assert.EqualError(t, date.UnmarshalText([]byte("c. 1564")), "invalid date 'c. 1564'")
+		assert.Equal(t, PartialDate{}, date)
+	})
+
+	t.Run("is used for the author dates", func(t *testing.T) {
+		var author Author
+
+		err := xml.Unmarshal([]byte("<author><id>1</id><born_at>1965/07/31</born_at><died_at/></author>"), &author)
+
+		assert.NoError(t, err)
+		assert.Equal(t, PartialDate{Year: 1965, Month: 7, Day: 31}, author.BornDate)
+		assert.True(t, author.DiedAt.IsZero())
+	})
+
+	t.Run("leaves the author dates which can't be parsed to zero", func(t *testing.T) {
+		var author Author
+
+		err := xml.Unmarshal([]byte("<author><id>1</id><born_at>c. 1564</born_at><died_at>1616/02/30</died_at></author>"), &author)
+
+		assert.NoError(t, err)
+		assert.True(t, author.BornDate.IsZero())
+		assert.True(t, author.DiedAt.IsZero())
+	})
+}
@@ -0,0 +1,38 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type quoteResponse struct {
+	Quote Quote `xml:"quote"`
+}
+
+// CreateQuote adds a quote from the book, the book ID is optional (0)
+func (c client) CreateQuote(ctx context.Context, authorName string, bookID Int, body string, tags []string) (Quote, error) {
+	var response = quoteResponse{}
+
+	form := url.Values{}
+	form.Set("quote[author_name]", authorName)
+	form.Set("quote[body]", body)
+
+	if bookID != 0 {
+		form.Set("quote[book_id]", strconv.Itoa(int(bookID)))
+	}
+
+	if len(tags) > 0 {
+		form.Set("quote[tags]", strings.Join(tags, ","))
+	}
+
+	err := c.Post(ctx, "/quotes", form, &response)
+
+	if err != nil {
+		return Quote{}, fmt.Errorf("failed to create the quote of '%s': %w", authorName, err)
+	}
+
+	return response.Quote, nil
+}
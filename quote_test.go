@@ -0,0 +1,90 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_CreateQuote(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts the quote and returns the created one", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "//quotes", r.URL.Path)
+			assert.Equal(t, "Nicholas Eames", r.PostFormValue("quote[author_name]"))
+			assert.Equal(t, "30841984", r.PostFormValue("quote[book_id]"))
+			assert.Equal(t, "Even in the darkest night, there's a band playing somewhere.", r.PostFormValue("quote[body]"))
+			assert.Equal(t, "hope,music", r.PostFormValue("quote[tags]"))
+
+			content, _ := ioutil.ReadFile("fixtures/create_quote.xml")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		quote, err := client.CreateQuote(ctx, "Nicholas Eames", 30841984, "Even in the darkest night, there's a band playing somewhere.", []string{"hope", "music"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, Quote{
+			ID:         9871233,
+			Body:       "Even in the darkest night, there's a band playing somewhere.",
+			AuthorID:   15388346,
+			AuthorName: "Nicholas Eames",
+			BookID:     30841984,
+			Tags:       []string{"hope", "music"},
+		}, quote)
+	})
+
+	t.Run("does not send the book and tags when missing", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.NoError(t, r.ParseForm())
+			assert.NotContains(t, r.PostForm, "quote[book_id]")
+			assert.NotContains(t, r.PostForm, "quote[tags]")
+
+			content, _ := ioutil.ReadFile("fixtures/create_quote.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		_, err := client.CreateQuote(ctx, "Nicholas Eames", 0, "Even in the darkest night", nil)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		quote, err := client.CreateQuote(ctx, "Nicholas Eames", 0, "Even in the darkest night", nil)
+
+		assert.EqualError(t, err, "failed to create the quote of 'Nicholas Eames': request failed for '//quotes': 401 Unauthorized")
+		assert.Equal(t, Quote{}, quote)
+	})
+}
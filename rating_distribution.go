@@ -0,0 +1,147 @@
+package goodreads
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RatingDistribution the number of ratings per star of a work
+// Stars[0] is the number of 1 star ratings, Stars[4] the number of 5 stars
+type RatingDistribution struct {
+	Stars [5]int
+	Total int
+}
+
+// UnmarshalText parses the Goodreads format: "5:193920|4:34869|3:8013|2:1605|1:1510|total:239917"
+// An empty value gives an empty distribution, the total is computed when missing
+func (r *RatingDistribution) UnmarshalText(text []byte) error {
+	*r = RatingDistribution{}
+
+	value := strings.TrimSpace(string(text))
+
+	if value == "" {
+		return nil
+	}
+
+	hasTotal := false
+
+	for _, pair := range strings.Split(value, "|") {
+		parts := strings.SplitN(pair, ":", 2)
+
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid rating distribution '%s'", value)
+		}
+
+		count, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+
+		if err != nil {
+			return fmt.Errorf("invalid rating distribution '%s': %w", value, err)
+		}
+
+		key := strings.TrimSpace(parts[0])
+
+		if key == "total" {
+			r.Total = count
+			hasTotal = true
+			continue
+		}
+
+		star, err := strconv.Atoi(key)
+
+		if err != nil || star < 1 || star > 5 {
+			return fmt.Errorf("invalid rating distribution '%s': unknown star '%s'", value, key)
+		}
+
+		r.Stars[star-1] = count
+	}
+
+	if !hasTotal {
+		r.Total = r.count()
+	}
+
+	return nil
+}
+
+// UnmarshalXML decodes the element with UnmarshalText, a distribution which can't be parsed
+// is left empty instead of failing the whole response, strict mode reports it
+func (r *RatingDistribution) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value string
+
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+
+	if err := r.UnmarshalText([]byte(value)); err != nil {
+		*r = RatingDistribution{}
+	}
+
+	return nil
+}
+
+// Count returns the number of ratings for the star (1 to 5)
+func (r RatingDistribution) Count(star int) int {
+	if star < 1 || star > 5 {
+		return 0
+	}
+
+	return r.Stars[star-1]
+}
+
+// Average the mean rating, 0 if there's no ratings
+func (r RatingDistribution) Average() float64 {
+	count := r.count()
+
+	if count == 0 {
+		return 0
+	}
+
+	return float64(r.sum()) / float64(count)
+}
+
+// Percentage the share of ratings for the star (1 to 5), from 0 to 100
+func (r RatingDistribution) Percentage(star int) float64 {
+	count := r.count()
+
+	if count == 0 {
+		return 0
+	}
+
+	return float64(r.Count(star)) * 100 / float64(count)
+}
+
+// Bayesian the average weighted toward priorMean as if there was priorWeight more
+// ratings of priorMean, so works with a few ratings don't outrank the popular ones
+// The usual priorMean is the average rating of all the works compared
+func (r RatingDistribution) Bayesian(priorMean float64, priorWeight int) float64 {
+	count := r.count()
+
+	if count+priorWeight == 0 {
+		return 0
+	}
+
+	return (priorMean*float64(priorWeight) + float64(r.sum())) / float64(priorWeight+count)
+}
+
+// count the number of ratings, from the stars since the total can be rounded
+func (r RatingDistribution) count() int {
+	count := 0
+
+	for _, c := range r.Stars {
+		count += c
+	}
+
+	return count
+}
+
+// sum the sum of all the ratings
+func (r RatingDistribution) sum() int {
+	sum := 0
+
+	for i, c := range r.Stars {
+		sum += (i + 1) * c
+	}
+
+	return sum
+}
@@ -0,0 +1,101 @@
+package goodreads
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRatingDistribution_UnmarshalText(t *testing.T) {
+	t.Run("parses the stars and the total", func(t *testing.T) {
+		var r RatingDistribution
+
+		err := r.UnmarshalText([]byte("5:193920|4:34869|3:8013|2:1605|1:1510|total:239917"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, RatingDistribution{
+			Stars: [5]int{1510, 1605, 8013, 34869, 193920},
+			Total: 239917,
+		}, r)
+	})
+
+	t.Run("computes the total when missing", func(t *testing.T) {
+		var r RatingDistribution
+
+		err := r.UnmarshalText([]byte("5:3|1:1"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, RatingDistribution{Stars: [5]int{1, 0, 0, 0, 3}, Total: 4}, r)
+	})
+
+	t.Run("gives an empty distribution for an empty value", func(t *testing.T) {
+		var r = RatingDistribution{Total: 12}
+
+		assert.NoError(t, r.UnmarshalText([]byte("  ")))
+		assert.Equal(t, RatingDistribution{}, r)
+	})
+
+	t.Run("is used when decoding a work", func(t *testing.T) {
+		var w Work
+
+		err := xml.Unmarshal([]byte("<work><id>1</id><rating_dist>5:2|4:1|3:0|2:0|1:0|total:3</rating_dist></work>"), &w)
+
+		assert.NoError(t, err)
+		assert.Equal(t, RatingDistribution{Stars: [5]int{0, 0, 0, 1, 2}, Total: 3}, w.RatingDist)
+	})
+
+	t.Run("leaves a malformed distribution empty when decoding a work", func(t *testing.T) {
+		var w Work
+
+		err := xml.Unmarshal([]byte("<work><id>1</id><rating_dist>5:10|4:|total:10</rating_dist></work>"), &w)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Int(1), w.WorkID)
+		assert.Equal(t, RatingDistribution{}, w.RatingDist)
+	})
+
+	t.Run("returns an error if the value is malformed", func(t *testing.T) {
+		var r RatingDistribution
+
+		assert.EqualError(t, r.UnmarshalText([]byte("5:12|4")), "invalid rating distribution '5:12|4'")
+		assert.EqualError(t, r.UnmarshalText([]byte("6:12")), "invalid rating distribution '6:12': unknown star '6'")
+		assert.EqualError(t, r.UnmarshalText([]byte("5:lots")), "invalid rating distribution '5:lots': strconv.Atoi: parsing \"lots\": invalid syntax")
+	})
+}
+
+func TestRatingDistribution_Stats(t *testing.T) {
+	r := RatingDistribution{
+		Stars: [5]int{1510, 1605, 8013, 34869, 193920},
+		Total: 239917,
+	}
+
+	t.Run("Count", func(t *testing.T) {
+		assert.Equal(t, 193920, r.Count(5))
+		assert.Equal(t, 1510, r.Count(1))
+		assert.Equal(t, 0, r.Count(0))
+		assert.Equal(t, 0, r.Count(6))
+	})
+
+	t.Run("Average", func(t *testing.T) {
+		assert.InDelta(t, 1137835.0/239917.0, r.Average(), 1e-9)
+		assert.Equal(t, 0.0, RatingDistribution{}.Average())
+	})
+
+	t.Run("Percentage", func(t *testing.T) {
+		assert.InDelta(t, 80.827, r.Percentage(5), 0.001)
+		assert.InDelta(t, 0.629, r.Percentage(1), 0.001)
+		assert.Equal(t, 0.0, RatingDistribution{}.Percentage(5))
+	})
+
+	t.Run("Bayesian", func(t *testing.T) {
+		few := RatingDistribution{Stars: [5]int{0, 0, 0, 0, 2}, Total: 2}
+
+		assert.Equal(t, 5.0, few.Average())
+		assert.InDelta(t, (3.8*100+10)/102, few.Bayesian(3.8, 100), 1e-9)
+		assert.Greater(t, r.Bayesian(3.8, 100), few.Bayesian(3.8, 100))
+		assert.Equal(t, few.Average(), few.Bayesian(3.8, 0))
+		assert.Equal(t, 3.8, RatingDistribution{}.Bayesian(3.8, 100))
+		assert.Equal(t, 0.0, RatingDistribution{}.Bayesian(3.8, 0))
+	})
+}
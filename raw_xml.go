@@ -0,0 +1,181 @@
+package goodreads
+
+import (
+	"reflect"
+	"strings"
+)
+
+// RawXML the xml inside an entity as Goodreads sent it, to read the fields not mapped yet
+// It's only captured with the WithRawXML option, it's empty otherwise
+type RawXML []byte
+
+// WithRawXML keeps the raw xml of the books, authors, works and series in their Raw field
+// The responses are then read in memory and parsed a second time to find it
+func WithRawXML() Option {
+	return func(c *client) {
+		c.rawXML = true
+	}
+}
+
+// Find returns the trimmed text of the first element matching the path, the path is the
+// element names separated by '>' like in the struct tags, "@name" at the end reads an
+// attribute: "work>rating_dist", "popular_shelves>shelf@count"
+func (r RawXML) Find(path string) (string, bool) {
+	values := r.FindAll(path)
+
+	if len(values) == 0 {
+		return "", false
+	}
+
+	return values[0], true
+}
+
+// FindAll returns the trimmed texts of all the elements matching the path, see Find
+func (r RawXML) FindAll(path string) []string {
+	values := []string{}
+
+	if len(r) == 0 || path == "" {
+		return values
+	}
+
+	root, err := parseNode([]byte("<raw>" + string(r) + "</raw>"))
+
+	if err != nil {
+		return values
+	}
+
+	attr := ""
+
+	if i := strings.LastIndex(path, "@"); i >= 0 {
+		path, attr = path[:i], path[i+1:]
+	}
+
+	for _, n := range findNodes(root, strings.Split(path, ">")) {
+		if attr == "" {
+			values = append(values, strings.TrimSpace(n.text))
+			continue
+		}
+
+		if value, ok := n.attrs[attr]; ok {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+var rawXMLType = reflect.TypeOf(RawXML{})
+
+// fillRawXML sets the Raw fields of the response from the content it was decoded from
+// It follows the xml tags like encoding/xml, it must run before the decoded lists are reordered
+func fillRawXML(content []byte, response interface{}) {
+	root, err := parseNode(content)
+
+	if err != nil {
+		return
+	}
+
+	fillRawValue(content, root, reflect.ValueOf(response))
+}
+
+func fillRawValue(content []byte, n *node, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			fillRawValue(content, n, v.Elem())
+		}
+	case reflect.Struct:
+		fillRawStruct(content, n, v)
+	}
+}
+
+func fillRawStruct(content []byte, n *node, v reflect.Value) {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+
+		if f.Anonymous {
+			fillRawValue(content, n, v.Field(i))
+			continue
+		}
+
+		if f.Type == rawXMLType {
+			fillRawField(content, n, v.Field(i), f.Tag.Get("goodreads"))
+			continue
+		}
+
+		name := strings.Split(f.Tag.Get("xml"), ",")[0]
+
+		if name == "-" || strings.Contains(f.Tag.Get("xml"), ",") {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		matches := findNodes(n, strings.Split(name, ">"))
+		field := v.Field(i)
+
+		if field.Kind() != reflect.Slice {
+			if len(matches) > 0 {
+				fillRawValue(content, matches[0], field)
+			}
+
+			continue
+		}
+
+		// encoding/xml appends the elements in the order they come
+		for j := 0; j < len(matches) && j < field.Len(); j++ {
+			fillRawValue(content, matches[j], field.Index(j))
+		}
+	}
+}
+
+// fillRawField sets the content of the element, tagged `goodreads:"raw"`, or of one of its
+// children with `goodreads:"raw=child>path"`
+func fillRawField(content []byte, n *node, field reflect.Value, tag string) {
+	if !strings.HasPrefix(tag, "raw") {
+		return
+	}
+
+	if path := strings.TrimPrefix(strings.TrimPrefix(tag, "raw"), "="); path != "" {
+		matches := findNodes(n, strings.Split(path, ">"))
+
+		if len(matches) == 0 {
+			return
+		}
+
+		n = matches[0]
+	}
+
+	raw := make(RawXML, n.inner[1]-n.inner[0])
+	copy(raw, content[n.inner[0]:n.inner[1]])
+	field.Set(reflect.ValueOf(raw))
+}
+
+// findNodes returns the descendants of n matching the path
+func findNodes(n *node, path []string) []*node {
+	nodes := []*node{n}
+
+	for _, name := range path {
+		next := []*node{}
+
+		for _, current := range nodes {
+			for _, child := range current.children {
+				if child.name == name {
+					next = append(next, child)
+				}
+			}
+		}
+
+		nodes = next
+	}
+
+	return nodes
+}
@@ -0,0 +1,167 @@
+package goodreads
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRawXML_Find(t *testing.T) {
+	raw := RawXML(`
+		<id>862041</id>
+		<marketplace_id><![CDATA[ ABC ]]></marketplace_id>
+		<popular_shelves>
+			<shelf name="to-read" count="44129"/>
+			<shelf name="fantasy" count="3162"/>
+		</popular_shelves>
+		<work><id>2962492</id><work_uri>kca://work/1</work_uri></work>`)
+
+	t.Run("finds the first element", func(t *testing.T) {
+		value, ok := raw.Find("work>work_uri")
+
+		assert.True(t, ok)
+		assert.Equal(t, "kca://work/1", value)
+
+		value, ok = raw.Find("marketplace_id")
+
+		assert.True(t, ok)
+		assert.Equal(t, "ABC", value)
+	})
+
+	t.Run("finds the attributes", func(t *testing.T) {
+		value, ok := raw.Find("popular_shelves>shelf@count")
+
+		assert.True(t, ok)
+		assert.Equal(t, "44129", value)
+		assert.Equal(t, []string{"to-read", "fantasy"}, raw.FindAll("popular_shelves>shelf@name"))
+	})
+
+	t.Run("finds all the elements", func(t *testing.T) {
+		assert.Equal(t, []string{"862041"}, raw.FindAll("id"))
+		assert.Equal(t, []string{"", ""}, raw.FindAll("popular_shelves>shelf"))
+	})
+
+	t.Run("returns nothing for a missing path", func(t *testing.T) {
+		value, ok := raw.Find("work>original_title")
+
+		assert.False(t, ok)
+		assert.Equal(t, "", value)
+		assert.Equal(t, []string{}, raw.FindAll("popular_shelves>shelf@missing"))
+		assert.Equal(t, []string{}, raw.FindAll(""))
+		assert.Equal(t, []string{}, RawXML(nil).FindAll("id"))
+		assert.Equal(t, []string{}, RawXML("<id>").FindAll("id"))
+	})
+}
+
+func TestWithRawXML(t *testing.T) {
+	var ctx = context.TODO()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, _ := ioutil.ReadFile("fixtures/get_one_book.xml")
+		_, _ = fmt.Fprintln(w, string(content))
+	}))
+	defer ts.Close()
+
+	t.Run("keeps the raw xml of the entities", func(t *testing.T) {
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		WithRawXML()(&client)
+
+		book, err := client.GetOneBook(ctx, 862041)
+
+		assert.NoError(t, err)
+
+		value, _ := book.Raw.Find("reviews_widget")
+		assert.Contains(t, value, "goodreads-widget")
+		assert.Len(t, book.Raw.FindAll("popular_shelves>shelf@name"), 100)
+
+		value, _ = book.Work.Raw.Find("work_uri")
+		assert.Equal(t, "kca://work/amzn1.gr.work.v1.LxhLt2ofNzpl6IkVpaQGgg", value)
+
+		value, _ = book.Authors[0].Raw.Find("average_rating")
+		assert.Equal(t, "4.46", value)
+
+		value, _ = book.SeriesWorks[0].Raw.Find("series_works_count")
+		assert.Equal(t, "16", value)
+
+		value, _ = book.SimilarBooks[0].Raw.Find("title_without_series")
+		assert.Equal(t, "The Hunger Games Trilogy Boxset", value)
+	})
+
+	t.Run("does not keep the raw xml without the option", func(t *testing.T) {
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		book, err := client.GetOneBook(ctx, 862041)
+
+		assert.NoError(t, err)
+		assert.Nil(t, book.Raw)
+		assert.Nil(t, book.Work.Raw)
+		assert.Nil(t, book.Authors[0].Raw)
+		assert.Nil(t, book.SeriesWorks[0].Raw)
+		assert.Nil(t, book.SimilarBooks[0].Raw)
+	})
+
+	t.Run("is set by the option", func(t *testing.T) {
+		assert.True(t, NewClient("awesomesuperapikey11", WithRawXML()).(client).rawXML)
+	})
+}
+
+func TestFillRawXML(t *testing.T) {
+	content := []byte(`<GoodreadsResponse><Request><key>secret</key></Request><book><id>2</id><work><id>3</id></work><authors><author><id>4</id></author><author><id>5</id><role/></author></authors></book></GoodreadsResponse>`)
+
+	var response getOneBook
+
+	assert.NoError(t, xml.Unmarshal(content, &response))
+
+	fillRawXML(content, &response)
+
+	assert.Equal(t, RawXML(`<id>2</id><work><id>3</id></work><authors><author><id>4</id></author><author><id>5</id><role/></author></authors>`), response.Book.Raw)
+	assert.Equal(t, RawXML(`<id>3</id>`), response.Book.Work.Raw)
+	assert.Equal(t, RawXML(`<id>4</id>`), response.Book.Authors[0].Raw)
+	assert.Equal(t, RawXML(`<id>5</id><role/>`), response.Book.Authors[1].Raw)
+}
+
+func TestWithRawXML_Series(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, _ := ioutil.ReadFile("fixtures/get_one_series_with_some_works.xml")
+		_, _ = fmt.Fprintln(w, strings.Replace(string(content), "<key><![CDATA[]]></key>", "<key>123</key>", 1))
+	}))
+	defer ts.Close()
+
+	client := client{
+		APIKey: "123",
+		domain: ts.URL,
+		http:   ts.Client(),
+	}
+
+	WithRawXML()(&client)
+
+	series, err := client.GetOneSeries(context.TODO(), 193556, 1)
+
+	assert.NoError(t, err)
+
+	value, _ := series.Raw.Find("series_works_count")
+	assert.Equal(t, "3", value)
+
+	_, found := series.Raw.Find("Request>key")
+	assert.False(t, found)
+	assert.NotContains(t, string(series.Raw), "Request")
+
+	value, _ = series.Works[0].Work.Raw.Find("best_book>title")
+	assert.Equal(t, "Kings of the Wyld (The Band, #1)", value)
+}
@@ -0,0 +1,82 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetReadingOrders returns the reading order of every series the book is part of
+// It gets the work of the book, the series of this work then all the works of each series
+func (c client) GetReadingOrders(ctx context.Context, bookID Int) ([]ReadingOrder, error) {
+	book, err := c.GetOneBook(ctx, bookID)
+
+	if err != nil {
+		return []ReadingOrder{}, fmt.Errorf("failed to get the reading orders of the book #%d: %w", bookID, err)
+	}
+
+	if book.Work.WorkID == 0 {
+		return []ReadingOrder{}, fmt.Errorf("failed to get the reading orders of the book #%d: the book has no work", bookID)
+	}
+
+	allSeries, err := c.GetAllSeriesForWork(ctx, book.Work.WorkID)
+
+	if err != nil {
+		return []ReadingOrder{}, fmt.Errorf("failed to get the reading orders of the book #%d: %w", bookID, err)
+	}
+
+	orders := []ReadingOrder{}
+
+	for _, series := range allSeries {
+		withWorks, err := c.AllSeriesWorks(ctx, series.ID)
+
+		if err != nil {
+			return []ReadingOrder{}, fmt.Errorf("failed to get the reading orders of the book #%d: %w", bookID, err)
+		}
+
+		orders = append(orders, ReadingOrder{
+			SeriesWithWorks: withWorks,
+			Current:         indexOfWork(withWorks.Works, book.Work.WorkID),
+		})
+	}
+
+	return orders, nil
+}
+
+// indexOfWork returns the index of the first entry of the work, -1 if it's not there
+func indexOfWork(works []SeriesWork, workID Int) int {
+	for i, work := range works {
+		if work.Work.WorkID == workID {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Position returns the position of the book in the series, not Valid if it's not found
+func (o ReadingOrder) Position() SeriesPosition {
+	if o.Current < 0 || o.Current >= len(o.Works) {
+		return SeriesPosition{}
+	}
+
+	return o.Works[o.Current].Position
+}
+
+// Next returns the primary work to read after the book
+// After a range (a box set "1-3") it's the first primary work after the end of the range,
+// there's none after the last primary work or when the book has no numbered position
+func (o ReadingOrder) Next() (SeriesWork, bool) {
+	current := o.Position()
+
+	if !current.Valid {
+		return SeriesWork{}, false
+	}
+
+	for _, work := range o.Works[o.Current+1:] {
+		if work.Primary() && work.Position.Start > current.End {
+			return work, true
+		}
+	}
+
+	return SeriesWork{}, false
+}
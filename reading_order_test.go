@@ -0,0 +1,123 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetReadingOrders(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the series of the book in reading order", func(t *testing.T) {
+		requests := []string{}
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.URL.Path)
+
+			fixtures := map[string]string{
+				"//book/show":           "fixtures/get_one_book.xml",
+				"//series/work/2962492": "fixtures/get_all_series_for_book_work.xml",
+				"//series/show/45175":   "fixtures/get_one_series_reading_order.xml",
+			}
+
+			content, _ := ioutil.ReadFile(fixtures[r.URL.Path])
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		orders, err := client.GetReadingOrders(ctx, 862041)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"//book/show", "//series/work/2962492", "//series/show/45175"}, requests)
+		assert.Len(t, orders, 1)
+
+		order := orders[0]
+
+		assert.Equal(t, Int(45175), order.ID)
+		assert.Len(t, order.Works, 7)
+		assert.Equal(t, 2, order.Current)
+		assert.Equal(t, "1-2", order.Position().String())
+
+		next, found := order.Next()
+
+		assert.True(t, found)
+		assert.Equal(t, Int(5), next.ID)
+		assert.Len(t, order.PrimaryWorks(), 3)
+		assert.Len(t, order.CompanionWorks(), 4)
+	})
+
+	t.Run("returns an error if a call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "//book/show" {
+				content, _ := ioutil.ReadFile("fixtures/get_one_book.xml")
+				_, _ = fmt.Fprintln(w, string(content))
+
+				return
+			}
+
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		orders, err := client.GetReadingOrders(ctx, 862041)
+
+		assert.EqualError(t, err, "failed to get the reading orders of the book #862041: failed to get the series for the work #2962492: request failed for '//series/work/2962492': 500 Internal Server Error")
+		assert.Equal(t, []ReadingOrder{}, orders)
+	})
+}
+
+func TestReadingOrder_Next(t *testing.T) {
+	work := func(id Int, text string) SeriesWork {
+		w := SeriesWork{ID: id}
+		_ = w.Position.UnmarshalText([]byte(text))
+
+		return w
+	}
+
+	order := ReadingOrder{
+		SeriesWithWorks: SeriesWithWorks{
+			Works: []SeriesWork{work(1, "1"), work(2, "1.5"), work(3, "2"), work(4, "Omnibus")},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		current  int
+		expected SeriesWork
+		found    bool
+	}{
+		{"after a primary work", 0, work(3, "2"), true},
+		{"after a novella", 1, work(3, "2"), true},
+		{"after the last primary work", 2, SeriesWork{}, false},
+		{"without a numbered position", 3, SeriesWork{}, false},
+		{"when the book is not in the series", -1, SeriesWork{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order.Current = tt.current
+
+			next, found := order.Next()
+
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.expected, next)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type getRecommendationResponse struct {
+	Recommendation Recommendation `xml:"recommendation"`
+}
+
+// GetRecommendation retrieve a specific recommendation
+func (c client) GetRecommendation(ctx context.Context, recommendationID Int) (Recommendation, error) {
+	var response = getRecommendationResponse{}
+
+	err := c.Get(ctx, fmt.Sprintf("/recommendations/%d", recommendationID), url.Values{}, &response)
+
+	if err != nil {
+		return Recommendation{}, fmt.Errorf("failed to get the recommendation #%d: %w", recommendationID, err)
+	}
+
+	return response.Recommendation, nil
+}
@@ -0,0 +1,71 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetRecommendation(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the recommendation", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//recommendations/1130098", r.URL.Path)
+
+			content, _ := ioutil.ReadFile("fixtures/get_recommendation.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		recommendation, err := client.GetRecommendation(ctx, 1130098)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Recommendation{
+			ID:        1130098,
+			Message:   "You'll love the band!",
+			CreatedAt: "2020-04-20T18:00:00-07:00",
+			FromUser: User{
+				ID:   2,
+				Name: "Elizabeth",
+			},
+			ToUser: User{
+				ID:   3,
+				Name: "Jonathan",
+			},
+			Book: Book{
+				ID:    30841984,
+				Title: "Kings of the Wyld (The Band, #1)",
+			},
+		}, recommendation)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		recommendation, err := client.GetRecommendation(ctx, 1130098)
+
+		assert.EqualError(t, err, "failed to get the recommendation #1130098: request failed for '//recommendations/1130098': 404 Not Found")
+		assert.Equal(t, Recommendation{}, recommendation)
+	})
+}
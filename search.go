@@ -7,13 +7,34 @@ import (
 	"strconv"
 )
 
+// SearchField restricts the search to one field
+type SearchField string
+
+// The fields the search can be restricted to
+const (
+	SearchFieldAll    SearchField = "all"
+	SearchFieldTitle  SearchField = "title"
+	SearchFieldAuthor SearchField = "author"
+)
+
+// SearchOptions optional parameters of the search
+// empty values are not sent, Goodreads then searches all the fields
+type SearchOptions struct {
+	Field SearchField
+}
+
 type searchResponse struct {
-	Results []Work `xml:"search>results>work"`
+	Query            string `xml:"search>query"`
+	Start            Int    `xml:"search>results-start"`
+	End              Int    `xml:"search>results-end"`
+	Total            Int    `xml:"search>total-results"`
+	QueryTimeSeconds Float  `xml:"search>query-time-seconds"`
+	Results          []Work `xml:"search>results>work"`
 }
 
 // Search find any book by title, author or isbn
-// for pagination 0 or 1 seems to be the same thing
-func (c client) Search(ctx context.Context, searchQuery string, page int) ([]Work, error) {
+// for pagination 0 or 1 seems to be the same thing, use SearchIterator to walk all the pages
+func (c client) Search(ctx context.Context, searchQuery string, page int, opts SearchOptions) (SearchResult, error) {
 	var response = searchResponse{
 		Results: []Work{},
 	}
@@ -22,11 +43,24 @@ func (c client) Search(ctx context.Context, searchQuery string, page int) ([]Wor
 	q.Set("q", searchQuery)
 	q.Set("page", strconv.Itoa(page))
 
+	if opts.Field != "" {
+		q.Set("search[field]", string(opts.Field))
+	}
+
 	err := c.Get(ctx, "/search/index", q, &response)
 
 	if err != nil {
-		return []Work{}, fmt.Errorf("'%s' search at page %d failed: %w", searchQuery, page, err)
+		return SearchResult{}, fmt.Errorf("'%s' search at page %d failed: %w", searchQuery, page, err)
 	}
 
-	return response.Results, nil
+	return SearchResult{
+		Pagination: Pagination{
+			Start: response.Start,
+			End:   response.End,
+			Total: response.Total,
+		},
+		Query:            response.Query,
+		QueryTimeSeconds: float64(response.QueryTimeSeconds),
+		Works:            response.Results,
+	}, nil
 }
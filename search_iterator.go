@@ -0,0 +1,95 @@
+package goodreads
+
+import (
+	"context"
+)
+
+// SearchIterator walks through all the pages of a search, one work at a time
+//
+//	it := goodreads.NewSearchIterator(gr, "harry potter", goodreads.SearchOptions{}, 50)
+//	for it.Next(ctx) {
+//		work := it.Work()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type SearchIterator struct {
+	client      Client
+	searchQuery string
+	opts        SearchOptions
+	maxResults  int
+
+	page     int
+	lastPage bool
+	buffer   []Work
+	current  Work
+	seen     map[Int]bool
+	count    int
+	err      error
+}
+
+// NewSearchIterator creates an iterator over the results of the search
+// maxResults caps the number of works returned, 0 means no cap
+func NewSearchIterator(c Client, searchQuery string, opts SearchOptions, maxResults int) *SearchIterator {
+	return &SearchIterator{
+		client:      c,
+		searchQuery: searchQuery,
+		opts:        opts,
+		maxResults:  maxResults,
+		seen:        map[Int]bool{},
+	}
+}
+
+// Next moves to the next work, fetching the next page when needed
+// It returns false when there's no more works or an error occurred, see Err
+// Works already returned are skipped, results can shift between pages while iterating
+func (it *SearchIterator) Next(ctx context.Context) bool {
+	for {
+		if it.err != nil || (it.maxResults > 0 && it.count >= it.maxResults) {
+			return false
+		}
+
+		if len(it.buffer) == 0 {
+			if it.lastPage {
+				return false
+			}
+
+			it.page++
+
+			result, err := it.client.Search(ctx, it.searchQuery, it.page, it.opts)
+
+			if err != nil {
+				it.err = err
+				return false
+			}
+
+			it.buffer = result.Works
+			it.lastPage = !result.HasNextPage() || len(result.Works) == 0
+
+			continue
+		}
+
+		work := it.buffer[0]
+		it.buffer = it.buffer[1:]
+
+		if it.seen[work.WorkID] {
+			continue
+		}
+
+		it.seen[work.WorkID] = true
+		it.current = work
+		it.count++
+
+		return true
+	}
+}
+
+// Work returns the current work, only valid after a call to Next returning true
+func (it *SearchIterator) Work() Work {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *SearchIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,130 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func searchPagesServer(t *testing.T, requestedPages *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		*requestedPages = append(*requestedPages, page)
+
+		assert.Equal(t, "the band", r.URL.Query().Get("q"))
+		assert.Equal(t, "title", r.URL.Query().Get("search[field]"))
+
+		content, err := ioutil.ReadFile(fmt.Sprintf("fixtures/search_page_%s.xml", page))
+
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		_, _ = fmt.Fprintln(w, string(content))
+	}))
+}
+
+func workIDs(it *SearchIterator) []Int {
+	ids := []Int{}
+
+	for it.Next(context.TODO()) {
+		ids = append(ids, it.Work().WorkID)
+	}
+
+	return ids
+}
+
+func TestSearchIterator(t *testing.T) {
+	opts := SearchOptions{Field: SearchFieldTitle}
+
+	t.Run("it walks all the pages and skips the works already seen", func(t *testing.T) {
+		requestedPages := []string{}
+		ts := searchPagesServer(t, &requestedPages)
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		it := NewSearchIterator(client, "the band", opts, 0)
+
+		assert.Equal(t, []Int{1, 2, 3, 4, 5}, workIDs(it))
+		assert.NoError(t, it.Err())
+		assert.Equal(t, []string{"1", "2"}, requestedPages)
+		assert.False(t, it.Next(context.TODO()))
+	})
+
+	t.Run("it stops at the max results", func(t *testing.T) {
+		requestedPages := []string{}
+		ts := searchPagesServer(t, &requestedPages)
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		it := NewSearchIterator(client, "the band", opts, 2)
+
+		assert.Equal(t, []Int{1, 2}, workIDs(it))
+		assert.NoError(t, it.Err())
+		assert.Equal(t, []string{"1"}, requestedPages)
+	})
+
+	t.Run("it stops on a search without results", func(t *testing.T) {
+		requestedPages := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestedPages++
+
+			content, _ := ioutil.ReadFile("fixtures/search_with_no_result.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		it := NewSearchIterator(client, "random stuff", SearchOptions{}, 0)
+
+		assert.Equal(t, []Int{}, workIDs(it))
+		assert.NoError(t, it.Err())
+		assert.Equal(t, 1, requestedPages)
+	})
+
+	t.Run("it stops and keeps the error if a page failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("page") == "2" {
+				http.Error(w, "wtf", http.StatusInternalServerError)
+				return
+			}
+
+			content, _ := ioutil.ReadFile("fixtures/search_page_1.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		it := NewSearchIterator(client, "the band", opts, 0)
+
+		assert.Equal(t, []Int{1, 2, 3}, workIDs(it))
+		assert.EqualError(t, it.Err(), "'the band' search at page 2 failed: request failed for '//search/index': 500 Internal Server Error")
+		assert.False(t, it.Next(context.TODO()))
+	})
+}
@@ -16,6 +16,10 @@ func TestClient_Search(t *testing.T) {
 
 	t.Run("it decodes the search response into the given struct", func(t *testing.T) {
 		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "hairy pooter", r.URL.Query().Get("q"))
+			assert.Equal(t, "0", r.URL.Query().Get("page"))
+			assert.NotContains(t, r.URL.Query(), "search[field]")
+
 			content, _ := ioutil.ReadFile("fixtures/search_with_result.xml")
 			_, _ = fmt.Fprintln(w, string(content))
 		}))
@@ -27,9 +31,13 @@ func TestClient_Search(t *testing.T) {
 			http:   ts.Client(),
 		}
 
-		books, err := client.Search(ctx, "hairy pooter", 0)
+		result, err := client.Search(ctx, "hairy pooter", 0, SearchOptions{})
 
 		assert.NoError(t, err)
+		assert.Equal(t, Pagination{Start: 1, End: 1, Total: 1}, result.Pagination)
+		assert.Equal(t, "hairy pooter", result.Query)
+		assert.Equal(t, 0.10, result.QueryTimeSeconds)
+		assert.False(t, result.HasNextPage())
 		assert.Equal(t, []Work{{
 			WorkID:        1111,
 			BookID:        35052265,
@@ -40,12 +48,34 @@ func TestClient_Search(t *testing.T) {
 				ID:   15388346,
 				Name: "John",
 			},
-			OriginalPublicationDate: OriginalPublicationDate{
+			BooksCount:       11,
+			TextReviewsCount: 11,
+			OriginalPublicationDate: PartialDate{
 				Year:  2018,
 				Month: 8,
 				Day:   28,
 			},
-		}}, books)
+		}}, result.Works)
+	})
+
+	t.Run("it restricts the search to the given field", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "title", r.URL.Query().Get("search[field]"))
+
+			content, _ := ioutil.ReadFile("fixtures/search_with_result.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		_, err := client.Search(ctx, "hairy pooter", 1, SearchOptions{Field: SearchFieldTitle})
+
+		assert.NoError(t, err)
 	})
 
 	t.Run("it decodes the search response into an empty slice if no result", func(t *testing.T) {
@@ -61,10 +91,15 @@ func TestClient_Search(t *testing.T) {
 			http:   ts.Client(),
 		}
 
-		books, err := client.Search(ctx, "hairy pooter", 0)
+		result, err := client.Search(ctx, "hairy pooter", 0, SearchOptions{})
 
 		assert.NoError(t, err)
-		assert.Equal(t, []Work{}, books)
+		assert.Equal(t, SearchResult{
+			Pagination:       Pagination{Start: 1, End: 0, Total: 0},
+			Query:            "random stuff",
+			QueryTimeSeconds: 0.04,
+			Works:            []Work{},
+		}, result)
 	})
 
 	t.Run("it returns an error if something went wrong", func(t *testing.T) {
@@ -79,9 +114,9 @@ func TestClient_Search(t *testing.T) {
 			http:   ts.Client(),
 		}
 
-		books, err := client.Search(ctx, "hairy pooter", 0)
+		result, err := client.Search(ctx, "hairy pooter", 0, SearchOptions{})
 
 		assert.EqualError(t, err, "'hairy pooter' search at page 0 failed: request failed for '//search/index': 500 Internal Server Error")
-		assert.Equal(t, []Work{}, books)
+		assert.Equal(t, SearchResult{}, result)
 	})
 }
@@ -11,12 +11,18 @@ type getAllSeriesForWorkResponse struct {
 	Results []Series `xml:"series_works>series_work"`
 }
 
+// getOneSeriesResponse is decoded in place of SeriesWithWorks to get the pagination
+// of the <series_works> element, encoding/xml can't map both the element and its children
 type getOneSeriesResponse struct {
-	SeriesWithWorks
+	Series
+	WorkList struct {
+		Pagination
+		Works []SeriesWork `xml:"series_work"`
+	} `xml:"series>series_works"`
 }
 
 // GetAllSeriesForWork See all series a work is in
-func (c client) GetAllSeriesForWork(ctx context.Context, workID int) ([]Series, error) {
+func (c client) GetAllSeriesForWork(ctx context.Context, workID Int) ([]Series, error) {
 	var response = getAllSeriesForWorkResponse{
 		Results: []Series{},
 	}
@@ -30,15 +36,12 @@ func (c client) GetAllSeriesForWork(ctx context.Context, workID int) ([]Series,
 	return response.Results, nil
 }
 
-// GetOneSeries Get info on a given series, includes the works in the series
+// GetOneSeries Get info on a given series, includes the works in the series in reading order
 // For pagination 0 or 1 seems to be the same thing.
-// The pagination will paginate the works if there's more than 100~
-func (c client) GetOneSeries(ctx context.Context, serieID int, page int) (SeriesWithWorks, error) {
-	var response = getOneSeriesResponse{
-		SeriesWithWorks{
-			Works: []Work{},
-		},
-	}
+// The pagination will paginate the works if there's more than 100~, see AllSeriesWorks to get all the pages
+func (c client) GetOneSeries(ctx context.Context, serieID Int, page int) (SeriesWithWorks, error) {
+	var response = getOneSeriesResponse{}
+	response.WorkList.Works = []SeriesWork{}
 
 	q := url.Values{}
 	q.Set("page", strconv.Itoa(page))
@@ -49,5 +52,65 @@ func (c client) GetOneSeries(ctx context.Context, serieID int, page int) (Series
 		return SeriesWithWorks{}, fmt.Errorf("failed to get the work for the series #%d in page #%d: %w", serieID, page, err)
 	}
 
-	return response.SeriesWithWorks, nil
+	SortSeriesWorks(response.WorkList.Works)
+
+	return SeriesWithWorks{
+		Series:     response.Series,
+		Works:      response.WorkList.Works,
+		Pagination: response.WorkList.Pagination,
+	}, nil
+}
+
+// AllSeriesWorks returns the series with the works of all the pages in reading order
+// When Goodreads doesn't send the pagination it stops once it has SeriesWorksCount works
+// It stops after maxPages pages with the works fetched so far and an error wrapping ErrTooManyPages
+func (c client) AllSeriesWorks(ctx context.Context, serieID Int) (SeriesWithWorks, error) {
+	var all = SeriesWithWorks{
+		Works: []SeriesWork{},
+	}
+
+	for page := 1; ; page++ {
+		if page > maxPages {
+			SortSeriesWorks(all.Works)
+
+			return all, fmt.Errorf("failed to get all the works for the series #%d: %w", serieID, ErrTooManyPages)
+		}
+
+		series, err := c.GetOneSeries(ctx, serieID, page)
+
+		if err != nil {
+			return SeriesWithWorks{}, err
+		}
+
+		all.Series = series.Series
+		all.Works = append(all.Works, series.Works...)
+
+		total := series.Pagination.Total
+
+		if total == 0 {
+			total = series.SeriesWorksCount
+		}
+
+		all.Pagination = Pagination{Start: 1, End: Int(len(all.Works)), Total: total}
+
+		if isLastSeriesPage(series, len(all.Works)) {
+			SortSeriesWorks(all.Works)
+
+			return all, nil
+		}
+	}
+}
+
+// isLastSeriesPage tells if there's no more works after this page
+// When Goodreads doesn't send the pagination it relies on SeriesWorksCount
+func isLastSeriesPage(series SeriesWithWorks, fetched int) bool {
+	if len(series.Works) == 0 {
+		return true
+	}
+
+	if series.Pagination.Total == 0 {
+		return fetched >= int(series.SeriesWorksCount)
+	}
+
+	return !series.Pagination.HasNextPage()
 }
@@ -0,0 +1,135 @@
+package goodreads
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SeriesPosition where a work stands in a series, as set by the Goodreads librarians
+// "1" and "1.5" are single positions, "1-7" a range (box sets, omnibus), anything
+// else ("Omnibus", "prequel", empty) has no number and Valid is false
+type SeriesPosition struct {
+	Raw   string
+	Start float64
+	End   float64
+	Valid bool
+}
+
+// UnmarshalText parses the position, it never fails: the unknown formats are kept in Raw
+func (p *SeriesPosition) UnmarshalText(text []byte) error {
+	*p = SeriesPosition{Raw: strings.TrimSpace(string(text))}
+
+	if p.Raw == "" {
+		return nil
+	}
+
+	bounds := strings.SplitN(p.Raw, "-", 2)
+
+	start, err := strconv.ParseFloat(strings.TrimSpace(bounds[0]), 64)
+
+	if err != nil || start < 0 {
+		return nil
+	}
+
+	end := start
+
+	if len(bounds) == 2 {
+		end, err = strconv.ParseFloat(strings.TrimSpace(bounds[1]), 64)
+
+		if err != nil || end < start {
+			return nil
+		}
+	}
+
+	p.Start, p.End, p.Valid = start, end, true
+
+	return nil
+}
+
+// MarshalText returns the position as Goodreads sent it
+func (p SeriesPosition) MarshalText() ([]byte, error) {
+	return []byte(p.Raw), nil
+}
+
+// String returns the position as Goodreads sent it
+func (p SeriesPosition) String() string {
+	return p.Raw
+}
+
+// IsRange tells if the position covers several works, like a box set "1-3"
+func (p SeriesPosition) IsRange() bool {
+	return p.Valid && p.End > p.Start
+}
+
+// IsWhole tells if the position is a single whole number, the position of the primary works
+func (p SeriesPosition) IsWhole() bool {
+	return p.Valid && !p.IsRange() && p.Start == float64(int(p.Start))
+}
+
+// Compare returns -1, 0 or 1 if the position comes before, with or after the other one
+// in reading order: by start then end ("1" < "1-3" < "1.5" < "2"), the positions
+// without a number come last, sorted by their text
+func (p SeriesPosition) Compare(other SeriesPosition) int {
+	switch {
+	case p.Valid && !other.Valid:
+		return -1
+	case !p.Valid && other.Valid:
+		return 1
+	case !p.Valid && !other.Valid:
+		return strings.Compare(p.Raw, other.Raw)
+	}
+
+	switch {
+	case p.Start < other.Start:
+		return -1
+	case p.Start > other.Start:
+		return 1
+	case p.End < other.End:
+		return -1
+	case p.End > other.End:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Primary tells if the work is one of the main works of the series, not a novella
+// or a companion: Goodreads only gives whole number positions to the primary works
+func (s SeriesWork) Primary() bool {
+	return s.Position.IsWhole()
+}
+
+// SortSeriesWorks sorts the works in reading order, see SeriesPosition.Compare
+// The works at the same position keep their order
+func SortSeriesWorks(works []SeriesWork) {
+	sort.SliceStable(works, func(i, j int) bool {
+		return works[i].Position.Compare(works[j].Position) < 0
+	})
+}
+
+// PrimaryWorks returns the primary works of the series, see SeriesWork.Primary
+func (s SeriesWithWorks) PrimaryWorks() []SeriesWork {
+	primary := []SeriesWork{}
+
+	for _, work := range s.Works {
+		if work.Primary() {
+			primary = append(primary, work)
+		}
+	}
+
+	return primary
+}
+
+// CompanionWorks returns the works of the series which are not primary: novellas, companions, box sets...
+func (s SeriesWithWorks) CompanionWorks() []SeriesWork {
+	companions := []SeriesWork{}
+
+	for _, work := range s.Works {
+		if !work.Primary() {
+			companions = append(companions, work)
+		}
+	}
+
+	return companions
+}
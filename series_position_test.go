@@ -0,0 +1,87 @@
+package goodreads
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeriesPosition_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected SeriesPosition
+	}{
+		{"1", SeriesPosition{Raw: "1", Start: 1, End: 1, Valid: true}},
+		{"1.5", SeriesPosition{Raw: "1.5", Start: 1.5, End: 1.5, Valid: true}},
+		{"0.5", SeriesPosition{Raw: "0.5", Start: 0.5, End: 0.5, Valid: true}},
+		{"1-7", SeriesPosition{Raw: "1-7", Start: 1, End: 7, Valid: true}},
+		{" 4 - 6 ", SeriesPosition{Raw: "4 - 6", Start: 4, End: 6, Valid: true}},
+		{"Omnibus", SeriesPosition{Raw: "Omnibus"}},
+		{"7-1", SeriesPosition{Raw: "7-1"}},
+		{"1-", SeriesPosition{Raw: "1-"}},
+		{"", SeriesPosition{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			var position SeriesPosition
+
+			assert.NoError(t, position.UnmarshalText([]byte(tt.text)))
+			assert.Equal(t, tt.expected, position)
+		})
+	}
+}
+
+func TestSeriesPosition_Compare(t *testing.T) {
+	position := func(text string) SeriesPosition {
+		var p SeriesPosition
+		_ = p.UnmarshalText([]byte(text))
+
+		return p
+	}
+
+	assert.Equal(t, -1, position("1").Compare(position("1.5")))
+	assert.Equal(t, -1, position("1").Compare(position("1-3")))
+	assert.Equal(t, -1, position("1-3").Compare(position("1.5")))
+	assert.Equal(t, 1, position("10").Compare(position("2")))
+	assert.Equal(t, 0, position("2").Compare(position("2.0")))
+	assert.Equal(t, -1, position("100").Compare(position("Omnibus")))
+	assert.Equal(t, -1, position("").Compare(position("Omnibus")))
+	assert.Equal(t, 1, position("Omnibus").Compare(position("")))
+}
+
+func TestSeriesWork_Primary(t *testing.T) {
+	work := func(text string) SeriesWork {
+		var work SeriesWork
+		_ = work.Position.UnmarshalText([]byte(text))
+
+		return work
+	}
+
+	assert.True(t, work("1").Primary())
+	assert.True(t, work("12").Primary())
+	assert.False(t, work("0.5").Primary())
+	assert.False(t, work("1.5").Primary())
+	assert.False(t, work("1-7").Primary())
+	assert.False(t, work("Omnibus").Primary())
+	assert.False(t, work("").Primary())
+}
+
+func TestSortSeriesWorks(t *testing.T) {
+	works := []SeriesWork{
+		{ID: 1, Position: SeriesPosition{Raw: "Omnibus"}},
+		{ID: 2, Position: SeriesPosition{Raw: "2", Start: 2, End: 2, Valid: true}},
+		{ID: 3, Position: SeriesPosition{Raw: "1", Start: 1, End: 1, Valid: true}},
+		{ID: 4, Position: SeriesPosition{Raw: "2", Start: 2, End: 2, Valid: true}},
+	}
+
+	SortSeriesWorks(works)
+
+	ids := []Int{}
+
+	for _, work := range works {
+		ids = append(ids, work.ID)
+	}
+
+	assert.Equal(t, []Int{3, 2, 4, 1}, ids)
+}
@@ -2,6 +2,7 @@ package goodreads
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -108,62 +109,143 @@ func TestClient_GetOneSeries(t *testing.T) {
 				PrimaryWorkCount: 3,
 				Numbered:         true,
 			},
-			[]Work{
+			[]SeriesWork{
 				{
-					WorkID:        51246585,
-					BookID:        30841984,
-					Title:         "Kings of the Wyld (The Band, #1)",
-					OriginalTitle: "Kings of the Wyld",
-					ImageURL:      "https://image.jpg",
-					SmallImageURL: "",
-					Author: Author{
-						ID:   15388346,
-						Name: "Nicholas Eames",
-					},
-					OriginalPublicationDate: OriginalPublicationDate{
-						Year:  2017,
-						Month: 2,
-						Day:   21,
+					ID:       988716,
+					Position: SeriesPosition{Raw: "1", Start: 1, End: 1, Valid: true},
+					Work: Work{
+						WorkID:        51246585,
+						BookID:        30841984,
+						Title:         "Kings of the Wyld (The Band, #1)",
+						OriginalTitle: "Kings of the Wyld",
+						ImageURL:      "https://image.jpg",
+						SmallImageURL: "",
+						Author: Author{
+							ID:   15388346,
+							Name: "Nicholas Eames",
+						},
+						BooksCount:       28,
+						ReviewsCount:     61104,
+						RatingsSum:       85255,
+						RatingsCount:     19708,
+						TextReviewsCount: 3247,
+						OriginalPublicationDate: PartialDate{
+							Year:  2017,
+							Month: 2,
+							Day:   21,
+						},
 					},
 				},
 				{
-					WorkID:        56340013,
-					BookID:        35052265,
-					Title:         "Bloody Rose (The Band, #2)",
-					OriginalTitle: "Bloody Rose",
-					ImageURL:      "https://image2.jpg",
-					SmallImageURL: "",
-					Author: Author{
-						ID:   15388346,
-						Name: "Nicholas Eames",
-					},
-					OriginalPublicationDate: OriginalPublicationDate{
-						Year:  2018,
-						Month: 8,
-						Day:   28,
+					ID:       1055937,
+					Position: SeriesPosition{Raw: "2", Start: 2, End: 2, Valid: true},
+					Work: Work{
+						WorkID:        56340013,
+						BookID:        35052265,
+						Title:         "Bloody Rose (The Band, #2)",
+						OriginalTitle: "Bloody Rose",
+						ImageURL:      "https://image2.jpg",
+						SmallImageURL: "",
+						Author: Author{
+							ID:   15388346,
+							Name: "Nicholas Eames",
+						},
+						BooksCount:       17,
+						ReviewsCount:     22046,
+						RatingsSum:       32709,
+						RatingsCount:     7692,
+						TextReviewsCount: 1135,
+						OriginalPublicationDate: PartialDate{
+							Year:  2018,
+							Month: 8,
+							Day:   28,
+						},
 					},
 				},
 				{
-					WorkID:        52587935,
-					BookID:        31932963,
-					Title:         "Outlaw Empire (The Band, #3)",
-					OriginalTitle: "Outlaw Empire",
-					ImageURL:      "https://image3.png",
-					SmallImageURL: "",
-					Author: Author{
-						ID:   15388346,
-						Name: "Nicholas Eames",
-					},
-					OriginalPublicationDate: OriginalPublicationDate{
-						Year:  0,
-						Month: 0,
-						Day:   0,
+					ID:       1055938,
+					Position: SeriesPosition{Raw: "3", Start: 3, End: 3, Valid: true},
+					Work: Work{
+						WorkID:        52587935,
+						BookID:        31932963,
+						Title:         "Outlaw Empire (The Band, #3)",
+						OriginalTitle: "Outlaw Empire",
+						ImageURL:      "https://image3.png",
+						SmallImageURL: "",
+						Author: Author{
+							ID:   15388346,
+							Name: "Nicholas Eames",
+						},
+						BooksCount:       2,
+						ReviewsCount:     2606,
+						RatingsSum:       63,
+						RatingsCount:     18,
+						TextReviewsCount: 7,
+						OriginalPublicationDate: PartialDate{
+							Year:  0,
+							Month: 0,
+							Day:   0,
+						},
 					},
 				},
 			},
+			Pagination{},
 		}, works)
 	})
 
+	t.Run("it sorts the works in reading order", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			content, _ := ioutil.ReadFile("fixtures/get_one_series_with_mixed_positions.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		series, err := client.GetOneSeries(ctx, 45175, 0)
+
+		assert.NoError(t, err)
+
+		positions := []string{}
+
+		for _, work := range series.Works {
+			positions = append(positions, work.Position.String())
+		}
+
+		assert.Equal(t, []string{"0.5", "1", "1-3", "2", "3", "", "Omnibus"}, positions)
+
+		primary := []Int{}
+
+		for _, work := range series.PrimaryWorks() {
+			primary = append(primary, work.Work.WorkID)
+		}
+
+		assert.Equal(t, []Int{4640800, 6231171, 2402163}, primary)
+	})
+
+	t.Run("it leaves the blank numbers to zero", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintln(w, `<GoodreadsResponse><series><id>193556</id><series_works_count> </series_works_count><numbered>
+</numbered></series></GoodreadsResponse>`)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		series, err := client.GetOneSeries(ctx, 193556, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Series{ID: 193556}, series.Series)
+	})
+
 	t.Run("it decodes the series response into the given struct with empty work array if no works", func(t *testing.T) {
 		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			content, _ := ioutil.ReadFile("fixtures/get_one_series_with_no_works.xml")
@@ -190,7 +272,8 @@ func TestClient_GetOneSeries(t *testing.T) {
 				PrimaryWorkCount: 3,
 				Numbered:         true,
 			},
-			[]Work{},
+			[]SeriesWork{},
+			Pagination{},
 		}, works)
 	})
 
@@ -212,3 +295,123 @@ func TestClient_GetOneSeries(t *testing.T) {
 		assert.Equal(t, SeriesWithWorks{}, works)
 	})
 }
+
+func TestClient_AllSeriesWorks(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the works of all the pages", func(t *testing.T) {
+		requestedPages := []string{}
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//series/show/193556", r.URL.Path)
+			requestedPages = append(requestedPages, r.URL.Query().Get("page"))
+
+			content, _ := ioutil.ReadFile(fmt.Sprintf("fixtures/get_one_series_page_%s.xml", r.URL.Query().Get("page")))
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		series, err := client.AllSeriesWorks(ctx, 193556)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1", "2"}, requestedPages)
+		assert.Equal(t, SeriesWithWorks{
+			Series: Series{
+				ID:               193556,
+				Title:            "The Band",
+				SeriesWorksCount: 3,
+				PrimaryWorkCount: 2,
+				Numbered:         true,
+			},
+			Works: []SeriesWork{
+				{
+					ID:       988716,
+					Position: SeriesPosition{Raw: "1", Start: 1, End: 1, Valid: true},
+					Work:     Work{WorkID: 51246585},
+				},
+				{
+					ID:       1431042,
+					Position: SeriesPosition{Raw: "1-2", Start: 1, End: 2, Valid: true},
+					Work:     Work{WorkID: 71880155},
+				},
+				{
+					ID:       1092519,
+					Position: SeriesPosition{Raw: "2", Start: 2, End: 2, Valid: true},
+					Work:     Work{WorkID: 56340013},
+				},
+			},
+			Pagination: Pagination{Start: 1, End: 3, Total: 3},
+		}, series)
+	})
+
+	t.Run("stops at the works count when there's no pagination", func(t *testing.T) {
+		requests := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+
+			content, _ := ioutil.ReadFile("fixtures/get_one_series_with_some_works.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		series, err := client.AllSeriesWorks(ctx, 193556)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, requests)
+		assert.Len(t, series.Works, 3)
+		assert.Equal(t, Pagination{Start: 1, End: 3, Total: 3}, series.Pagination)
+	})
+
+	t.Run("stops after too many pages with the works fetched so far", func(t *testing.T) {
+		requests := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+
+			content, _ := ioutil.ReadFile("fixtures/get_one_series_page_1.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		series, err := client.AllSeriesWorks(ctx, 193556)
+
+		assert.EqualError(t, err, "failed to get all the works for the series #193556: stopped after 100 pages")
+		assert.True(t, errors.Is(err, ErrTooManyPages))
+		assert.NotEmpty(t, series.Works)
+		assert.Equal(t, maxPages, requests)
+	})
+
+	t.Run("returns an error if a page failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		series, err := client.AllSeriesWorks(ctx, 193556)
+
+		assert.EqualError(t, err, "failed to get the work for the series #193556 in page #1: request failed for '//series/show/193556': 500 Internal Server Error")
+		assert.Equal(t, SeriesWithWorks{}, series)
+	})
+}
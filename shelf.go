@@ -0,0 +1,131 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ShelfOptions the flags of a shelf when creating or updating it
+// An exclusive shelf works like read / to-read, a book can only be in one of them
+type ShelfOptions struct {
+	Exclusive bool
+	Featured  bool
+	Sortable  bool
+}
+
+type listShelvesResponse struct {
+	Shelves []Shelf `xml:"shelves>user_shelf"`
+}
+
+type userShelfResponse struct {
+	Shelf Shelf `xml:"user_shelf"`
+}
+
+// ListShelves returns the shelves of the given user with their books count
+func (c client) ListShelves(ctx context.Context, userID Int) ([]Shelf, error) {
+	var response = listShelvesResponse{
+		Shelves: []Shelf{},
+	}
+
+	q := url.Values{}
+	q.Set("user_id", strconv.Itoa(int(userID)))
+
+	err := c.Get(ctx, "/shelf/list", q, &response)
+
+	if err != nil {
+		return []Shelf{}, fmt.Errorf("failed to get the shelves of the user #%d: %w", userID, err)
+	}
+
+	return response.Shelves, nil
+}
+
+// AddToShelf puts the book on one of the shelves of the authenticated user
+func (c client) AddToShelf(ctx context.Context, shelf string, bookID Int) error {
+	form := url.Values{}
+	form.Set("name", shelf)
+	form.Set("book_id", strconv.Itoa(int(bookID)))
+
+	err := c.Post(ctx, "/shelf/add_to_shelf", form, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to add the book #%d to the shelf '%s': %w", bookID, shelf, err)
+	}
+
+	return nil
+}
+
+// RemoveFromShelf takes the book off one of the shelves of the authenticated user
+func (c client) RemoveFromShelf(ctx context.Context, shelf string, bookID Int) error {
+	form := url.Values{}
+	form.Set("name", shelf)
+	form.Set("book_id", strconv.Itoa(int(bookID)))
+	form.Set("a", "remove")
+
+	err := c.Post(ctx, "/shelf/add_to_shelf", form, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to remove the book #%d from the shelf '%s': %w", bookID, shelf, err)
+	}
+
+	return nil
+}
+
+// AddBooksToShelves puts every book on every given shelves in one call
+func (c client) AddBooksToShelves(ctx context.Context, bookIDs []Int, shelves []string) error {
+	ids := make([]string, len(bookIDs))
+
+	for i, bookID := range bookIDs {
+		ids[i] = strconv.Itoa(int(bookID))
+	}
+
+	form := url.Values{}
+	form.Set("bookids", strings.Join(ids, ","))
+	form.Set("shelves", strings.Join(shelves, ","))
+
+	err := c.Post(ctx, "/shelf/add_books_to_shelves", form, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to add the books %v to the shelves %v: %w", bookIDs, shelves, err)
+	}
+
+	return nil
+}
+
+// CreateShelf adds a new shelf to the authenticated user
+func (c client) CreateShelf(ctx context.Context, name string, options ShelfOptions) (Shelf, error) {
+	var response = userShelfResponse{}
+
+	err := c.Post(ctx, "/user_shelves", shelfForm(name, options), &response)
+
+	if err != nil {
+		return Shelf{}, fmt.Errorf("failed to create the shelf '%s': %w", name, err)
+	}
+
+	return response.Shelf, nil
+}
+
+// UpdateShelf edits the name and flags of one of the authenticated user's shelves
+func (c client) UpdateShelf(ctx context.Context, shelfID Int, name string, options ShelfOptions) (Shelf, error) {
+	var response = userShelfResponse{}
+
+	err := c.Put(ctx, fmt.Sprintf("/user_shelves/%d", shelfID), shelfForm(name, options), &response)
+
+	if err != nil {
+		return Shelf{}, fmt.Errorf("failed to update the shelf #%d: %w", shelfID, err)
+	}
+
+	return response.Shelf, nil
+}
+
+func shelfForm(name string, options ShelfOptions) url.Values {
+	form := url.Values{}
+	form.Set("user_shelf[name]", name)
+	form.Set("user_shelf[exclusive_flag]", strconv.FormatBool(options.Exclusive))
+	form.Set("user_shelf[featured]", strconv.FormatBool(options.Featured))
+	form.Set("user_shelf[sortable_flag]", strconv.FormatBool(options.Sortable))
+
+	return form
+}
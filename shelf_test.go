@@ -0,0 +1,302 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ListShelves(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the shelves of the user", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "42", r.URL.Query().Get("user_id"))
+
+			content, _ := ioutil.ReadFile("fixtures/list_shelves.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		shelves, err := client.ListShelves(ctx, 42)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Shelf{
+			{
+				ID:        269274694,
+				Name:      "read",
+				BookCount: 112,
+				Exclusive: true,
+			},
+			{
+				ID:        269274695,
+				Name:      "currently-reading",
+				BookCount: 2,
+				Exclusive: true,
+			},
+			{
+				ID:          301118237,
+				Name:        "book-club",
+				BookCount:   14,
+				Description: "what we read together",
+				Featured:    true,
+			},
+		}, shelves)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		shelves, err := client.ListShelves(ctx, 42)
+
+		assert.EqualError(t, err, "failed to get the shelves of the user #42: request failed for '//shelf/list': 500 Internal Server Error")
+		assert.Equal(t, []Shelf{}, shelves)
+	})
+}
+
+func TestClient_AddToShelf(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts the book and the shelf", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "//shelf/add_to_shelf", r.URL.Path)
+			assert.Equal(t, "to-read", r.PostFormValue("name"))
+			assert.Equal(t, "1111", r.PostFormValue("book_id"))
+			assert.Equal(t, "", r.PostFormValue("a"))
+
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.AddToShelf(ctx, "to-read", 1111)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.AddToShelf(ctx, "to-read", 1111)
+
+		assert.EqualError(t, err, "failed to add the book #1111 to the shelf 'to-read': request failed for '//shelf/add_to_shelf': 401 Unauthorized")
+	})
+}
+
+func TestClient_RemoveFromShelf(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts the book and the shelf with the remove action", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "//shelf/add_to_shelf", r.URL.Path)
+			assert.Equal(t, "to-read", r.PostFormValue("name"))
+			assert.Equal(t, "1111", r.PostFormValue("book_id"))
+			assert.Equal(t, "remove", r.PostFormValue("a"))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.RemoveFromShelf(ctx, "to-read", 1111)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.RemoveFromShelf(ctx, "to-read", 1111)
+
+		assert.EqualError(t, err, "failed to remove the book #1111 from the shelf 'to-read': request failed for '//shelf/add_to_shelf': 404 Not Found")
+	})
+}
+
+func TestClient_AddBooksToShelves(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts the books and shelves as comma separated lists", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "//shelf/add_books_to_shelves", r.URL.Path)
+			assert.Equal(t, "1,2,3", r.PostFormValue("bookids"))
+			assert.Equal(t, "book-club,sci-fi", r.PostFormValue("shelves"))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.AddBooksToShelves(ctx, []Int{1, 2, 3}, []string{"book-club", "sci-fi"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.AddBooksToShelves(ctx, []Int{1, 2}, []string{"sci-fi"})
+
+		assert.EqualError(t, err, "failed to add the books [1 2] to the shelves [sci-fi]: request failed for '//shelf/add_books_to_shelves': 500 Internal Server Error")
+	})
+}
+
+func TestClient_CreateShelf(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts the shelf and returns the created one", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "//user_shelves", r.URL.Path)
+			assert.Equal(t, "sci-fi", r.PostFormValue("user_shelf[name]"))
+			assert.Equal(t, "false", r.PostFormValue("user_shelf[exclusive_flag]"))
+			assert.Equal(t, "true", r.PostFormValue("user_shelf[featured]"))
+			assert.Equal(t, "true", r.PostFormValue("user_shelf[sortable_flag]"))
+
+			content, _ := ioutil.ReadFile("fixtures/create_shelf.xml")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		shelf, err := client.CreateShelf(ctx, "sci-fi", ShelfOptions{Featured: true, Sortable: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, Shelf{
+			ID:       301118240,
+			Name:     "sci-fi",
+			Featured: true,
+			Sortable: true,
+		}, shelf)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusUnprocessableEntity)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		shelf, err := client.CreateShelf(ctx, "sci-fi", ShelfOptions{})
+
+		assert.EqualError(t, err, "failed to create the shelf 'sci-fi': request failed for '//user_shelves': 422 Unprocessable Entity")
+		assert.Equal(t, Shelf{}, shelf)
+	})
+}
+
+func TestClient_UpdateShelf(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("puts the shelf and returns the updated one", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "//user_shelves/301118240", r.URL.Path)
+			assert.Equal(t, "sci-fi", r.PostFormValue("user_shelf[name]"))
+			assert.Equal(t, "true", r.PostFormValue("user_shelf[featured]"))
+
+			content, _ := ioutil.ReadFile("fixtures/create_shelf.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		shelf, err := client.UpdateShelf(ctx, 301118240, "sci-fi", ShelfOptions{Featured: true, Sortable: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, Int(301118240), shelf.ID)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		shelf, err := client.UpdateShelf(ctx, 301118240, "sci-fi", ShelfOptions{})
+
+		assert.EqualError(t, err, "failed to update the shelf #301118240: request failed for '//user_shelves/301118240': 404 Not Found")
+		assert.Equal(t, Shelf{}, shelf)
+	})
+}
@@ -0,0 +1,133 @@
+package goodreads
+
+import (
+	"context"
+)
+
+// The Stream* methods send the items as soon as their page is downloaded.
+// The items channel is unbuffered so the next page is only fetched once the consumer
+// read the current one. Both channels are closed when the producer stops, the error
+// channel receives at most one error: the failed call, the cancellation of ctx or
+// the page safety cap.
+//
+//	books, errs := gr.StreamAuthorBooks(ctx, 15388346)
+//	for book := range books {
+//		...
+//	}
+//	if err := <-errs; err != nil {
+//		...
+//	}
+
+// streamPages calls fetch for each page until it returns the last one
+// it sends the first error to errs and closes it
+func streamPages(errs chan<- error, fetch func(page int) (last bool, err error)) {
+	defer close(errs)
+
+	for page := 1; page <= maxPages; page++ {
+		last, err := fetch(page)
+
+		if err != nil {
+			errs <- err
+			return
+		}
+
+		if last {
+			return
+		}
+	}
+
+	errs <- ErrTooManyPages
+}
+
+// StreamAuthorBooks sends the books of all the pages of the author
+func (c client) StreamAuthorBooks(ctx context.Context, authorID Int) (<-chan Book, <-chan error) {
+	books := make(chan Book)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(books)
+
+		streamPages(errs, func(page int) (bool, error) {
+			author, err := c.GetAuthorBooks(ctx, authorID, page)
+
+			if err != nil {
+				return false, err
+			}
+
+			for _, book := range author.Books {
+				select {
+				case books <- book:
+				case <-ctx.Done():
+					return false, ctx.Err()
+				}
+			}
+
+			return len(author.Books) == 0 || !author.Pagination.HasNextPage(), nil
+		})
+	}()
+
+	return books, errs
+}
+
+// StreamSeriesWorks sends the works of all the pages of the series
+// They are in reading order within a page, use AllSeriesWorks for the whole series
+func (c client) StreamSeriesWorks(ctx context.Context, serieID Int) (<-chan SeriesWork, <-chan error) {
+	works := make(chan SeriesWork)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(works)
+
+		fetched := 0
+
+		streamPages(errs, func(page int) (bool, error) {
+			series, err := c.GetOneSeries(ctx, serieID, page)
+
+			if err != nil {
+				return false, err
+			}
+
+			for _, work := range series.Works {
+				select {
+				case works <- work:
+				case <-ctx.Done():
+					return false, ctx.Err()
+				}
+			}
+
+			fetched += len(series.Works)
+
+			return isLastSeriesPage(series, fetched), nil
+		})
+	}()
+
+	return works, errs
+}
+
+// StreamSearch sends the works of all the pages of the search, see SearchIterator
+func (c client) StreamSearch(ctx context.Context, searchQuery string, opts SearchOptions) (<-chan Work, <-chan error) {
+	works := make(chan Work)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(works)
+		defer close(errs)
+
+		it := NewSearchIterator(c, searchQuery, opts, 0)
+
+		for it.Next(ctx) {
+			select {
+			case works <- it.Work():
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+
+		if err := it.Err(); err != nil {
+			errs <- err
+		}
+	}()
+
+	return works, errs
+}
@@ -0,0 +1,250 @@
+package goodreads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assertNoGoroutineLeak waits for the goroutines started since the baseline to stop
+func assertNoGoroutineLeak(t *testing.T, baseline int) {
+	deadline := time.Now().Add(2 * time.Second)
+
+	for runtime.NumGoroutine() > baseline && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	assert.LessOrEqual(t, runtime.NumGoroutine(), baseline, "goroutines leaked")
+}
+
+func authorBooksPagesServer(requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+
+		content, _ := ioutil.ReadFile(fmt.Sprintf("fixtures/get_author_books_page_%s.xml", r.URL.Query().Get("page")))
+		_, _ = fmt.Fprintln(w, string(content))
+	}))
+}
+
+func TestClient_StreamAuthorBooks(t *testing.T) {
+	t.Run("sends the books of all the pages", func(t *testing.T) {
+		baseline := runtime.NumGoroutine()
+
+		var requests int32
+		ts := authorBooksPagesServer(&requests)
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		books, errs := client.StreamAuthorBooks(context.TODO(), 15388346)
+
+		ids := []Int{}
+
+		for book := range books {
+			ids = append(ids, book.ID)
+		}
+
+		assert.NoError(t, <-errs)
+		assert.Equal(t, []Int{30841984, 35052265, 51573640}, ids)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+		ts.Close()
+		assertNoGoroutineLeak(t, baseline)
+	})
+
+	t.Run("waits for the consumer before fetching the next page", func(t *testing.T) {
+		var requests int32
+		ts := authorBooksPagesServer(&requests)
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		books, errs := client.StreamAuthorBooks(context.TODO(), 15388346)
+
+		assert.Equal(t, Int(30841984), (<-books).ID)
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+		for range books {
+		}
+
+		assert.NoError(t, <-errs)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("stops the producer when the context is cancelled", func(t *testing.T) {
+		baseline := runtime.NumGoroutine()
+
+		var requests int32
+		ts := authorBooksPagesServer(&requests)
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		books, errs := client.StreamAuthorBooks(ctx, 15388346)
+
+		<-books
+		cancel()
+
+		err := <-errs
+
+		assert.True(t, errors.Is(err, context.Canceled), "expected a cancellation, got %v", err)
+
+		_, open := <-books
+
+		assert.False(t, open)
+
+		ts.Close()
+		assertNoGoroutineLeak(t, baseline)
+	})
+
+	t.Run("sends the error if a page failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		books, errs := client.StreamAuthorBooks(context.TODO(), 15388346)
+
+		_, open := <-books
+
+		assert.False(t, open)
+		assert.EqualError(t, <-errs, "failed to get the books for the author #15388346 in page #1: request failed for '//author/list': 500 Internal Server Error")
+	})
+}
+
+func TestClient_StreamSeriesWorks(t *testing.T) {
+	t.Run("sends the works of all the pages", func(t *testing.T) {
+		baseline := runtime.NumGoroutine()
+
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			content, _ := ioutil.ReadFile(fmt.Sprintf("fixtures/get_one_series_page_%s.xml", r.URL.Query().Get("page")))
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		works, errs := client.StreamSeriesWorks(context.TODO(), 193556)
+
+		ids := []Int{}
+
+		for work := range works {
+			ids = append(ids, work.Work.WorkID)
+		}
+
+		assert.NoError(t, <-errs)
+		assert.Equal(t, []Int{51246585, 56340013, 71880155}, ids)
+
+		ts.Close()
+		assertNoGoroutineLeak(t, baseline)
+	})
+
+	t.Run("stops the producer when the context is cancelled", func(t *testing.T) {
+		baseline := runtime.NumGoroutine()
+
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			content, _ := ioutil.ReadFile("fixtures/get_one_series_page_1.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		works, errs := client.StreamSeriesWorks(ctx, 193556)
+
+		<-works
+		cancel()
+
+		assert.True(t, errors.Is(<-errs, context.Canceled))
+
+		ts.Close()
+		assertNoGoroutineLeak(t, baseline)
+	})
+}
+
+func TestClient_StreamSearch(t *testing.T) {
+	t.Run("sends the works of all the pages", func(t *testing.T) {
+		baseline := runtime.NumGoroutine()
+
+		requestedPages := []string{}
+		ts := searchPagesServer(t, &requestedPages)
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		works, errs := client.StreamSearch(context.TODO(), "the band", SearchOptions{Field: SearchFieldTitle})
+
+		ids := []Int{}
+
+		for work := range works {
+			ids = append(ids, work.WorkID)
+		}
+
+		assert.NoError(t, <-errs)
+		assert.Equal(t, []Int{1, 2, 3, 4, 5}, ids)
+
+		ts.Close()
+		assertNoGoroutineLeak(t, baseline)
+	})
+
+	t.Run("stops the producer when the context is cancelled", func(t *testing.T) {
+		baseline := runtime.NumGoroutine()
+
+		requestedPages := []string{}
+		ts := searchPagesServer(t, &requestedPages)
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		works, errs := client.StreamSearch(ctx, "the band", SearchOptions{Field: SearchFieldTitle})
+
+		<-works
+		cancel()
+
+		assert.True(t, errors.Is(<-errs, context.Canceled))
+
+		ts.Close()
+		assertNoGoroutineLeak(t, baseline)
+	})
+}
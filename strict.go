@@ -0,0 +1,413 @@
+package goodreads
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DecodeReport lists what the strict decoding found wrong in a response
+// The paths are the xml elements from the root, separated by '>'
+type DecodeReport struct {
+	Endpoint         string
+	UnknownElements  []string
+	ConversionErrors []ConversionError
+	MissingFields    []string
+}
+
+// ConversionError a value that doesn't fit the type of its field
+type ConversionError struct {
+	Path  string
+	Value string
+	Type  string
+	Err   error
+}
+
+func (e ConversionError) Error() string {
+	return fmt.Sprintf("cannot decode '%s' at '%s' into %s: %s", e.Value, e.Path, e.Type, e.Err)
+}
+
+// Empty tells if nothing was found wrong
+func (r DecodeReport) Empty() bool {
+	return len(r.UnknownElements) == 0 && len(r.ConversionErrors) == 0 && len(r.MissingFields) == 0
+}
+
+// WithStrictDecoding checks every response against the struct it's decoded into,
+// the hook receives a report when there's unknown elements (not mapped to any field),
+// values not matching their field type or missing required fields.
+// The decoding itself stays as lenient as without this option.
+func WithStrictDecoding(hook func(DecodeReport)) Option {
+	return func(c *client) {
+		c.strict = hook
+	}
+}
+
+// handDecoded is implemented by the models decoding some elements in their UnmarshalXML
+// instead of through a field, they must not be reported as unknown
+// Their values are checked against the given type as if they were decoded into it
+type handDecoded interface {
+	handDecodedElements() map[string]reflect.Type
+}
+
+// decodeStrict decodes the body into response and sends the report of what doesn't
+// match to the strict hook, even if the decoding failed
+func (c *client) decodeStrict(endpoint string, content []byte, response interface{}) error {
+	decodeErr := xml.Unmarshal(content, response)
+
+	root, err := parseNode(content)
+
+	if err != nil {
+		return decodeErr
+	}
+
+	report := DecodeReport{Endpoint: endpoint}
+	w := walker{report: &report, seen: map[string]bool{}}
+	w.walkStruct(root, reflect.TypeOf(response).Elem(), root.name, true)
+
+	if !report.Empty() {
+		c.strict(report)
+	}
+
+	return decodeErr
+}
+
+// node an xml element with its attributes and direct text
+// inner is where the content of the element is in the parsed bytes
+type node struct {
+	name     string
+	attrs    map[string]string
+	text     string
+	children []*node
+	inner    [2]int64
+}
+
+func parseNode(content []byte) (*node, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	stack := []*node{}
+
+	var root *node
+
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			n := &node{name: t.Name.Local, attrs: map[string]string{}}
+			n.inner[0] = decoder.InputOffset()
+
+			for _, attr := range t.Attr {
+				n.attrs[attr.Name.Local] = attr.Value
+			}
+
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, n)
+			} else if root == nil {
+				root = n
+			}
+
+			stack = append(stack, n)
+		case xml.EndElement:
+			stack[len(stack)-1].inner[1] = offset
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text += string(t)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, fmt.Errorf("no root element")
+	}
+
+	return root, nil
+}
+
+// fieldInfo the xml mapping of a struct field, embedded structs are flattened
+// The fields of an embedded pointer share its group: they are only required when
+// one of them is there, the pointer is nil otherwise
+type fieldInfo struct {
+	path     []string
+	typ      reflect.Type
+	catchAll bool
+	required bool
+	group    string
+}
+
+func structFields(t reflect.Type) []fieldInfo {
+	fields := []fieldInfo{}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("xml")
+
+		if tag == "-" || f.Name == "XMLName" || (f.PkgPath != "" && !f.Anonymous) {
+			continue
+		}
+
+		if f.Anonymous && deref(f.Type).Kind() == reflect.Struct {
+			embedded := structFields(deref(f.Type))
+
+			if f.Type.Kind() == reflect.Ptr {
+				for i := range embedded {
+					if embedded[i].group == "" {
+						embedded[i].group = f.Name
+					}
+				}
+			}
+
+			fields = append(fields, embedded...)
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		name := parts[0]
+
+		if i := strings.LastIndex(name, " "); i >= 0 {
+			name = name[i+1:]
+		}
+
+		info := fieldInfo{typ: f.Type, required: f.Tag.Get("goodreads") == "required"}
+		skip := false
+
+		for _, flag := range parts[1:] {
+			switch flag {
+			case "any":
+				info.catchAll = true
+			case "attr", "chardata", "cdata", "comment", "innerxml":
+				skip = true
+			}
+		}
+
+		if skip {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		info.path = strings.Split(name, ">")
+		fields = append(fields, info)
+	}
+
+	return fields
+}
+
+// pathTree the element names a struct maps, a field is set on the last element of its path
+type pathTree struct {
+	children map[string]*pathTree
+	field    *fieldInfo
+}
+
+func (p *pathTree) insert(path []string, field *fieldInfo) {
+	current := p
+
+	for _, name := range path {
+		if current.children == nil {
+			current.children = map[string]*pathTree{}
+		}
+
+		next, ok := current.children[name]
+
+		if !ok {
+			next = &pathTree{}
+			current.children[name] = next
+		}
+
+		current = next
+	}
+
+	current.field = field
+}
+
+var (
+	xmlUnmarshalerType  = reflect.TypeOf((*xml.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	handDecodedType     = reflect.TypeOf((*handDecoded)(nil)).Elem()
+)
+
+type walker struct {
+	report *DecodeReport
+	seen   map[string]bool
+}
+
+func (w walker) walkStruct(n *node, t reflect.Type, path string, root bool) {
+	fields := structFields(t)
+	tree := &pathTree{}
+	catchAll := false
+
+	for i := range fields {
+		if fields[i].catchAll {
+			catchAll = true
+			continue
+		}
+
+		tree.insert(fields[i].path, &fields[i])
+	}
+
+	if t.Implements(handDecodedType) {
+		for name, typ := range reflect.Zero(t).Interface().(handDecoded).handDecodedElements() {
+			tree.insert(strings.Split(name, ">"), &fieldInfo{typ: typ})
+		}
+	}
+
+	present := map[string]bool{}
+	w.walkChildren(n, tree, path, "", present, catchAll, root)
+
+	groups := map[string]bool{}
+
+	for _, f := range fields {
+		if f.group != "" && present[strings.Join(f.path, ">")] {
+			groups[f.group] = true
+		}
+	}
+
+	for _, f := range fields {
+		relative := strings.Join(f.path, ">")
+
+		if f.group != "" && !groups[f.group] {
+			continue
+		}
+
+		if f.required && !present[relative] {
+			w.add("missing", &w.report.MissingFields, path+">"+relative)
+		}
+	}
+}
+
+func (w walker) walkChildren(n *node, tree *pathTree, path string, relative string, present map[string]bool, catchAll bool, root bool) {
+	for _, child := range n.children {
+		childPath := path + ">" + child.name
+		childRelative := child.name
+
+		if relative != "" {
+			childRelative = relative + ">" + child.name
+		}
+
+		next, ok := tree.children[child.name]
+
+		if !ok {
+			// the envelope of every response, nobody maps it
+			if !catchAll && !(root && relative == "" && child.name == "Request") {
+				w.add("unknown", &w.report.UnknownElements, childPath)
+			}
+
+			continue
+		}
+
+		present[childRelative] = true
+
+		if next.field != nil {
+			w.walkValue(child, next.field.typ, childPath)
+			continue
+		}
+
+		w.walkChildren(child, next, childPath, childRelative, present, false, false)
+	}
+}
+
+func (w walker) walkValue(n *node, t reflect.Type, path string) {
+	t = deref(t)
+
+	if t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8 {
+		t = deref(t.Elem())
+	}
+
+	pointer := reflect.PtrTo(t)
+
+	// checked first, the lenient UnmarshalXML of a type can hide what its UnmarshalText rejects
+	if pointer.Implements(textUnmarshalerType) {
+		value := reflect.New(t).Interface().(encoding.TextUnmarshaler)
+
+		if err := value.UnmarshalText([]byte(n.text)); err != nil {
+			w.conversionError(n, t, path, err)
+		}
+
+		return
+	}
+
+	if pointer.Implements(xmlUnmarshalerType) {
+		if t.Kind() == reflect.Struct && t.Implements(handDecodedType) {
+			w.walkStruct(n, t, path, false)
+		}
+
+		return
+	}
+
+	// same rules as encoding/xml: only an empty value is zero, a blank one is trimmed and fails
+	text := strings.TrimSpace(n.text)
+	empty := n.text == ""
+
+	var err error
+
+	switch t.Kind() {
+	case reflect.Struct:
+		w.walkStruct(n, t, path, false)
+		return
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !empty {
+			_, err = strconv.ParseInt(text, 10, t.Bits())
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if !empty {
+			_, err = strconv.ParseUint(text, 10, t.Bits())
+		}
+	case reflect.Float32, reflect.Float64:
+		if !empty {
+			_, err = strconv.ParseFloat(text, t.Bits())
+		}
+	case reflect.Bool:
+		if !empty {
+			_, err = strconv.ParseBool(text)
+		}
+	}
+
+	if err != nil {
+		w.conversionError(n, t, path, err)
+	}
+}
+
+func (w walker) conversionError(n *node, t reflect.Type, path string, err error) {
+	w.report.ConversionErrors = append(w.report.ConversionErrors, ConversionError{
+		Path:  path,
+		Value: n.text,
+		Type:  t.String(),
+		Err:   err,
+	})
+}
+
+// add appends the path once, the items of a list share the same paths
+func (w walker) add(kind string, paths *[]string, path string) {
+	key := kind + ":" + path
+
+	if w.seen[key] {
+		return
+	}
+
+	w.seen[key] = true
+	*paths = append(*paths, path)
+}
+
+func deref(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t
+}
@@ -0,0 +1,277 @@
+package goodreads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func strictClient(ts *httptest.Server, reports *[]DecodeReport) client {
+	c := client{
+		APIKey: "123",
+		domain: ts.URL,
+		http:   ts.Client(),
+	}
+
+	WithStrictDecoding(func(report DecodeReport) {
+		*reports = append(*reports, report)
+	})(&c)
+
+	return c
+}
+
+func TestWithStrictDecoding(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("sets the hook on the client", func(t *testing.T) {
+		called := false
+		gr := NewClient("awesomesuperapikey11", WithStrictDecoding(func(DecodeReport) { called = true })).(client)
+
+		gr.strict(DecodeReport{})
+
+		assert.True(t, called)
+	})
+
+	t.Run("reports the unknown elements once and keeps the lenient decoding", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintln(w, `<GoodreadsResponse>
+				<Request><authentication>true</authentication></Request>
+				<book>
+					<id>862041</id>
+					<title>Harry Potter</title>
+					<publication_year>2007</publication_year>
+					<marketplace_id><![CDATA[]]></marketplace_id>
+					<similar_books>
+						<book><id>1</id><title_without_series>One</title_without_series></book>
+						<book><id>2</id><title_without_series>Two</title_without_series></book>
+					</similar_books>
+				</book>
+			</GoodreadsResponse>`)
+		}))
+		defer ts.Close()
+
+		reports := []DecodeReport{}
+		client := strictClient(ts, &reports)
+
+		book, err := client.GetOneBook(ctx, 862041)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Int(862041), book.ID)
+		assert.Equal(t, PartialDate{Year: 2007}, book.PublicationDate)
+		assert.Len(t, book.SimilarBooks, 2)
+		assert.Equal(t, []DecodeReport{{
+			Endpoint: "//book/show",
+			UnknownElements: []string{
+				"GoodreadsResponse>book>marketplace_id",
+				"GoodreadsResponse>book>similar_books>book>title_without_series",
+			},
+		}}, reports)
+	})
+
+	t.Run("reports the missing required fields", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintln(w, `<GoodreadsResponse><book><title>Harry Potter</title><work><id>1</id></work><authors><author><name>J.K. Rowling</name></author></authors></book></GoodreadsResponse>`)
+		}))
+		defer ts.Close()
+
+		reports := []DecodeReport{}
+		client := strictClient(ts, &reports)
+
+		_, err := client.GetOneBook(ctx, 862041)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []DecodeReport{{
+			Endpoint: "//book/show",
+			MissingFields: []string{
+				"GoodreadsResponse>book>authors>author>id",
+				"GoodreadsResponse>book>id",
+			},
+		}}, reports)
+	})
+
+	t.Run("reports the conversion errors even if the decoding failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintln(w, `<GoodreadsResponse><author><id>1077326</id><works_count>lots</works_count><born_at>in July</born_at></author></GoodreadsResponse>`)
+		}))
+		defer ts.Close()
+
+		reports := []DecodeReport{}
+		client := strictClient(ts, &reports)
+
+		_, err := client.GetOneAuthor(ctx, 1077326)
+
+		assert.Error(t, err)
+		assert.Len(t, reports, 1)
+		assert.Equal(t, []ConversionError{
+			{
+				Path:  "GoodreadsResponse>author>works_count",
+				Value: "lots",
+				Type:  "goodreads.Int",
+				Err:   &strconv.NumError{Func: "Atoi", Num: "lots", Err: strconv.ErrSyntax},
+			},
+			{
+				Path:  "GoodreadsResponse>author>born_at",
+				Value: "in July",
+				Type:  "goodreads.PartialDate",
+				Err:   errors.New("invalid date 'in July'"),
+			},
+		}, reports[0].ConversionErrors)
+		assert.EqualError(t, reports[0].ConversionErrors[0], "cannot decode 'lots' at 'GoodreadsResponse>author>works_count' into goodreads.Int: strconv.Atoi: parsing \"lots\": invalid syntax")
+	})
+
+	t.Run("reports the malformed rating distributions", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintln(w, `<GoodreadsResponse><book><id>1</id><work><id>2</id><rating_dist>5:10|4:|total:10</rating_dist></work></book></GoodreadsResponse>`)
+		}))
+		defer ts.Close()
+
+		reports := []DecodeReport{}
+		client := strictClient(ts, &reports)
+
+		book, err := client.GetOneBook(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, RatingDistribution{}, book.Work.RatingDist)
+		assert.Len(t, reports, 1)
+		assert.Equal(t, []ConversionError{
+			{
+				Path:  "GoodreadsResponse>book>work>rating_dist",
+				Value: "5:10|4:|total:10",
+				Type:  "goodreads.RatingDistribution",
+				Err:   fmt.Errorf("invalid rating distribution '5:10|4:|total:10': %w", &strconv.NumError{Func: "Atoi", Num: "", Err: strconv.ErrSyntax}),
+			},
+		}, reports[0].ConversionErrors)
+	})
+
+	t.Run("reports the malformed publication dates left to zero", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintln(w, `<GoodreadsResponse><book><id>1</id><publication_year>MMXVII</publication_year><work><id>2</id><original_publication_year>2017</original_publication_year><original_publication_month>May</original_publication_month></work></book></GoodreadsResponse>`)
+		}))
+		defer ts.Close()
+
+		reports := []DecodeReport{}
+		client := strictClient(ts, &reports)
+
+		book, err := client.GetOneBook(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.True(t, book.PublicationDate.IsZero())
+		assert.True(t, book.Work.OriginalPublicationDate.IsZero())
+		assert.Len(t, reports, 1)
+		assert.Equal(t, []ConversionError{
+			{
+				Path:  "GoodreadsResponse>book>publication_year",
+				Value: "MMXVII",
+				Type:  "goodreads.Int",
+				Err:   &strconv.NumError{Func: "Atoi", Num: "MMXVII", Err: strconv.ErrSyntax},
+			},
+			{
+				Path:  "GoodreadsResponse>book>work>original_publication_month",
+				Value: "May",
+				Type:  "goodreads.Int",
+				Err:   &strconv.NumError{Func: "Atoi", Num: "May", Err: strconv.ErrSyntax},
+			},
+		}, reports[0].ConversionErrors)
+	})
+
+	t.Run("does not call the hook when everything is mapped", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintln(w, `<GoodreadsResponse><Request><key>123</key></Request><author><id>1077326</id><name>J.K. Rowling</name><born_at>1965/07/31</born_at></author></GoodreadsResponse>`)
+		}))
+		defer ts.Close()
+
+		reports := []DecodeReport{}
+		client := strictClient(ts, &reports)
+
+		author, err := client.GetOneAuthor(ctx, 1077326)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "J.K. Rowling", author.Name)
+		assert.Empty(t, reports)
+	})
+
+	t.Run("reports the blank plain numbers like encoding/xml rejects them", func(t *testing.T) {
+		var response struct {
+			Count int  `xml:"count"`
+			Empty int  `xml:"empty"`
+			Flag  bool `xml:"flag"`
+		}
+
+		reports := []DecodeReport{}
+		c := client{strict: func(report DecodeReport) { reports = append(reports, report) }}
+
+		err := c.decodeStrict("//test", []byte(`<response><count> </count><empty></empty><flag>
+</flag></response>`), &response)
+
+		assert.Error(t, err)
+		assert.Len(t, reports, 1)
+		assert.Equal(t, []ConversionError{
+			{
+				Path:  "response>count",
+				Value: " ",
+				Type:  "int",
+				Err:   &strconv.NumError{Func: "ParseInt", Num: "", Err: strconv.ErrSyntax},
+			},
+			{
+				Path:  "response>flag",
+				Value: "\n",
+				Type:  "bool",
+				Err:   &strconv.NumError{Func: "ParseBool", Num: "", Err: strconv.ErrSyntax},
+			},
+		}, reports[0].ConversionErrors)
+	})
+
+	t.Run("accepts the blank numbers of the models", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintln(w, `<GoodreadsResponse><author><id>1077326</id><works_count><![CDATA[ ]]></works_count></author></GoodreadsResponse>`)
+		}))
+		defer ts.Close()
+
+		reports := []DecodeReport{}
+		client := strictClient(ts, &reports)
+
+		_, err := client.GetOneAuthor(ctx, 1077326)
+
+		assert.NoError(t, err)
+		assert.Empty(t, reports)
+	})
+
+	t.Run("requires the series of a series work only when it's there", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "//book/show" {
+				_, _ = fmt.Fprintln(w, `<GoodreadsResponse><book><id>1</id><series_works><series_work><id>2</id><series><title>Untitled</title></series></series_work></series_works></book></GoodreadsResponse>`)
+				return
+			}
+
+			content, _ := ioutil.ReadFile("fixtures/get_one_series_with_some_works.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		reports := []DecodeReport{}
+		client := strictClient(ts, &reports)
+
+		_, err := client.GetOneSeries(ctx, 193556, 1)
+
+		assert.NoError(t, err)
+
+		for _, report := range reports {
+			assert.Empty(t, report.MissingFields)
+		}
+
+		reports = reports[:0]
+
+		_, err = client.GetOneBook(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, reports, 1)
+		assert.Equal(t, []string{"GoodreadsResponse>book>series_works>series_work>series>id"}, reports[0].MissingFields)
+	})
+}
@@ -0,0 +1,122 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// TopicOptions everything needed to start a new topic
+// SubjectType is either "Book" or "Group"
+type TopicOptions struct {
+	SubjectType string
+	SubjectID   Int
+	FolderID    Int
+	Title       string
+	Question    bool
+	Comment     string
+	UpdateFeed  bool
+	DigestEmail bool
+}
+
+type getTopicResponse struct {
+	Topic Topic `xml:"topic"`
+}
+
+type getGroupFolderTopicsResponse struct {
+	Topics TopicList `xml:"group_folder>topics"`
+}
+
+type getUnreadGroupTopicsResponse struct {
+	Topics TopicList `xml:"group>topics"`
+}
+
+// GetTopic returns the topic with its first page of comments
+func (c client) GetTopic(ctx context.Context, topicID Int) (Topic, error) {
+	var response = getTopicResponse{}
+
+	err := c.Get(ctx, fmt.Sprintf("/topic/show/%d", topicID), url.Values{}, &response)
+
+	if err != nil {
+		return Topic{}, fmt.Errorf("failed to get the topic #%d: %w", topicID, err)
+	}
+
+	return response.Topic, nil
+}
+
+// GetGroupFolderTopics returns a paginated list of the topics in a group's folder
+func (c client) GetGroupFolderTopics(ctx context.Context, folderID Int, groupID Int, page int) (TopicList, error) {
+	var response = getGroupFolderTopicsResponse{
+		TopicList{
+			Topics: []Topic{},
+		},
+	}
+
+	q := url.Values{}
+	q.Set("group_id", strconv.Itoa(int(groupID)))
+	q.Set("page", strconv.Itoa(page))
+
+	err := c.Get(ctx, fmt.Sprintf("/topic/group_folder/%d", folderID), q, &response)
+
+	if err != nil {
+		return TopicList{}, fmt.Errorf("failed to get the topics of the folder #%d in the group #%d in page #%d: %w", folderID, groupID, page, err)
+	}
+
+	return response.Topics, nil
+}
+
+// GetUnreadGroupTopics returns a paginated list of the topics with unread comments in the group
+func (c client) GetUnreadGroupTopics(ctx context.Context, groupID Int, page int) (TopicList, error) {
+	var response = getUnreadGroupTopicsResponse{
+		TopicList{
+			Topics: []Topic{},
+		},
+	}
+
+	q := url.Values{}
+	q.Set("page", strconv.Itoa(page))
+
+	err := c.Get(ctx, fmt.Sprintf("/topic/unread_group/%d", groupID), q, &response)
+
+	if err != nil {
+		return TopicList{}, fmt.Errorf("failed to get the unread topics of the group #%d in page #%d: %w", groupID, page, err)
+	}
+
+	return response.Topics, nil
+}
+
+// CreateTopic starts a new topic, the comment is the first message of the topic
+func (c client) CreateTopic(ctx context.Context, options TopicOptions) (Topic, error) {
+	var response = getTopicResponse{}
+
+	form := url.Values{}
+	form.Set("topic[subject_type]", options.SubjectType)
+	form.Set("topic[subject_id]", strconv.Itoa(int(options.SubjectID)))
+	form.Set("topic[title]", options.Title)
+	form.Set("topic[question_flag]", boolFlag(options.Question))
+	form.Set("comment[body_usertext]", options.Comment)
+	form.Set("update_feed", boolFlag(options.UpdateFeed))
+	form.Set("digest", boolFlag(options.DigestEmail))
+
+	if options.FolderID != 0 {
+		form.Set("topic[folder_id]", strconv.Itoa(int(options.FolderID)))
+	}
+
+	err := c.Post(ctx, "/topic", form, &response)
+
+	if err != nil {
+		return Topic{}, fmt.Errorf("failed to create the topic '%s': %w", options.Title, err)
+	}
+
+	return response.Topic, nil
+}
+
+// boolFlag the forms of goodreads are expecting 1 or 0 for checkboxes
+func boolFlag(value bool) string {
+	if value {
+		return "1"
+	}
+
+	return "0"
+}
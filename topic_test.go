@@ -0,0 +1,265 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mayReadTopic = Topic{
+	ID:            20318032,
+	Title:         "May read: Kings of the Wyld",
+	CommentsCount: 2,
+	Author: User{
+		ID:   2,
+		Name: "Elizabeth",
+	},
+	CreatedAt:     "2020-05-01T09:00:00-07:00",
+	UpdatedAt:     "2020-05-03T21:14:27-07:00",
+	LastCommentAt: "2020-05-03T21:14:27-07:00",
+}
+
+func TestClient_GetTopic(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the topic with its comments", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//topic/show/20318032", r.URL.Path)
+
+			content, _ := ioutil.ReadFile("fixtures/get_topic.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		topic, err := client.GetTopic(ctx, 20318032)
+
+		expected := mayReadTopic
+		expected.SubjectType = "Group"
+		expected.SubjectID = 1865
+		expected.FolderID = 63214
+		expected.FolderName = "Monthly Reads"
+		expected.Comments = CommentList{
+			Pagination: Pagination{
+				Start: 1,
+				End:   2,
+				Total: 2,
+			},
+			Comments: []Comment{
+				{
+					ID:   200731221,
+					Body: "Who's in? <i>Let's go!</i>",
+					User: User{
+						ID:   2,
+						Name: "Elizabeth",
+					},
+					CreatedAt: "2020-05-01T09:00:00-07:00",
+					UpdatedAt: "2020-05-01T09:00:00-07:00",
+				},
+				{
+					ID:   200734519,
+					Body: "I'm in!",
+					User: User{
+						ID:   3,
+						Name: "Jonathan",
+					},
+					CreatedAt: "2020-05-03T21:14:27-07:00",
+					UpdatedAt: "2020-05-03T21:14:27-07:00",
+				},
+			},
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, topic)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		topic, err := client.GetTopic(ctx, 20318032)
+
+		assert.EqualError(t, err, "failed to get the topic #20318032: request failed for '//topic/show/20318032': 404 Not Found")
+		assert.Equal(t, Topic{}, topic)
+	})
+}
+
+func TestClient_GetGroupFolderTopics(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the page of topics in the folder", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//topic/group_folder/63214", r.URL.Path)
+			assert.Equal(t, "1865", r.URL.Query().Get("group_id"))
+			assert.Equal(t, "1", r.URL.Query().Get("page"))
+
+			content, _ := ioutil.ReadFile("fixtures/get_group_folder_topics.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		topics, err := client.GetGroupFolderTopics(ctx, 63214, 1865, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, TopicList{
+			Pagination: Pagination{
+				Start: 1,
+				End:   1,
+				Total: 40,
+			},
+			Topics: []Topic{mayReadTopic},
+		}, topics)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		topics, err := client.GetGroupFolderTopics(ctx, 63214, 1865, 1)
+
+		assert.EqualError(t, err, "failed to get the topics of the folder #63214 in the group #1865 in page #1: request failed for '//topic/group_folder/63214': 500 Internal Server Error")
+		assert.Equal(t, TopicList{}, topics)
+	})
+}
+
+func TestClient_GetUnreadGroupTopics(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the page of unread topics", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//topic/unread_group/1865", r.URL.Path)
+
+			content, _ := ioutil.ReadFile("fixtures/get_unread_group_topics.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		topics, err := client.GetUnreadGroupTopics(ctx, 1865, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, TopicList{
+			Pagination: Pagination{
+				Start: 1,
+				End:   1,
+				Total: 1,
+			},
+			Topics: []Topic{mayReadTopic},
+		}, topics)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		topics, err := client.GetUnreadGroupTopics(ctx, 1865, 1)
+
+		assert.EqualError(t, err, "failed to get the unread topics of the group #1865 in page #1: request failed for '//topic/unread_group/1865': 401 Unauthorized")
+		assert.Equal(t, TopicList{}, topics)
+	})
+}
+
+func TestClient_CreateTopic(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts the topic and returns the created one", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "//topic", r.URL.Path)
+			assert.Equal(t, "Group", r.PostFormValue("topic[subject_type]"))
+			assert.Equal(t, "1865", r.PostFormValue("topic[subject_id]"))
+			assert.Equal(t, "63214", r.PostFormValue("topic[folder_id]"))
+			assert.Equal(t, "May read: Kings of the Wyld", r.PostFormValue("topic[title]"))
+			assert.Equal(t, "0", r.PostFormValue("topic[question_flag]"))
+			assert.Equal(t, "Who's in?", r.PostFormValue("comment[body_usertext]"))
+			assert.Equal(t, "1", r.PostFormValue("update_feed"))
+			assert.Equal(t, "0", r.PostFormValue("digest"))
+
+			content, _ := ioutil.ReadFile("fixtures/get_topic.xml")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		topic, err := client.CreateTopic(ctx, TopicOptions{
+			SubjectType: "Group",
+			SubjectID:   1865,
+			FolderID:    63214,
+			Title:       "May read: Kings of the Wyld",
+			Comment:     "Who's in?",
+			UpdateFeed:  true,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, Int(20318032), topic.ID)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		topic, err := client.CreateTopic(ctx, TopicOptions{Title: "May read"})
+
+		assert.EqualError(t, err, "failed to create the topic 'May read': request failed for '//topic': 401 Unauthorized")
+		assert.Equal(t, Topic{}, topic)
+	})
+}
@@ -0,0 +1,126 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// UpdateType the kind of activity of an update
+type UpdateType string
+
+// The kinds of updates we know about, goodreads has more of them (comments, challenges...)
+const (
+	UpdateReview     UpdateType = "review"
+	UpdateUserStatus UpdateType = "userstatus"
+	UpdateReadStatus UpdateType = "readstatus"
+	UpdateFollow     UpdateType = "userfollowing"
+)
+
+// FriendUpdatesFilter what to get in the friend updates, empty values use the goodreads defaults
+// Type is one of "books", "reviews" or "statuses"
+// Filter is one of "friends", "following" or "top_friends"
+type FriendUpdatesFilter struct {
+	Type       string
+	Filter     string
+	MaxUpdates int
+}
+
+type getFriendUpdatesResponse struct {
+	Updates []Update `xml:"updates>update"`
+}
+
+type getNotificationsResponse struct {
+	Notifications []notification `xml:"notifications>notification"`
+}
+
+// notification goodreads describes them differently than the updates
+type notification struct {
+	Actors       []User      `xml:"actors>user"`
+	New          Bool        `xml:"new"`
+	CreatedAt    string      `xml:"created_at"`
+	URL          string      `xml:"url"`
+	ResourceType string      `xml:"resource_type"`
+	Text         string      `xml:"body>text"`
+	Review       *Review     `xml:"resource>review"`
+	UserStatus   *UserStatus `xml:"resource>user_status"`
+	ReadStatus   *ReadStatus `xml:"resource>read_status"`
+	Followed     *User       `xml:"resource>user"`
+}
+
+// GetFriendUpdates returns the recent activity of the authenticated user's friends
+func (c client) GetFriendUpdates(ctx context.Context, filter FriendUpdatesFilter) ([]Update, error) {
+	var response = getFriendUpdatesResponse{
+		Updates: []Update{},
+	}
+
+	q := url.Values{}
+
+	if filter.Type != "" {
+		q.Set("update", filter.Type)
+	}
+
+	if filter.Filter != "" {
+		q.Set("update_filter", filter.Filter)
+	}
+
+	if filter.MaxUpdates != 0 {
+		q.Set("max_updates", strconv.Itoa(filter.MaxUpdates))
+	}
+
+	err := c.Get(ctx, "/updates/friends", q, &response)
+
+	if err != nil {
+		return []Update{}, fmt.Errorf("failed to get the friend updates: %w", err)
+	}
+
+	return response.Updates, nil
+}
+
+// GetNotifications returns a paginated list of the authenticated user's notifications as updates
+func (c client) GetNotifications(ctx context.Context, page int) ([]Update, error) {
+	var response = getNotificationsResponse{
+		Notifications: []notification{},
+	}
+
+	q := url.Values{}
+	q.Set("page", strconv.Itoa(page))
+
+	err := c.Get(ctx, "/notifications", q, &response)
+
+	if err != nil {
+		return []Update{}, fmt.Errorf("failed to get the notifications in page #%d: %w", page, err)
+	}
+
+	updates := make([]Update, len(response.Notifications))
+
+	for i, n := range response.Notifications {
+		updates[i] = n.update()
+	}
+
+	return updates, nil
+}
+
+// update the resource type is in camel case (UserStatus) when the update type is not (userstatus)
+func (n notification) update() Update {
+	update := Update{
+		Type:       UpdateType(strings.ToLower(n.ResourceType)),
+		ActionText: n.Text,
+		Link:       n.URL,
+		UpdatedAt:  n.CreatedAt,
+		New:        bool(n.New),
+		Review:     n.Review,
+		UserStatus: n.UserStatus,
+		ReadStatus: n.ReadStatus,
+		Followed:   n.Followed,
+	}
+
+	if len(n.Actors) > 0 {
+		update.Actor = n.Actors[0]
+		update.ImageURL = n.Actors[0].ImageURL
+	}
+
+	return update
+}
@@ -0,0 +1,221 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetFriendUpdates(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the updates with their object", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//updates/friends", r.URL.Path)
+			assert.Equal(t, "reviews", r.URL.Query().Get("update"))
+			assert.Equal(t, "top_friends", r.URL.Query().Get("update_filter"))
+			assert.Equal(t, "4", r.URL.Query().Get("max_updates"))
+
+			content, _ := ioutil.ReadFile("fixtures/get_friend_updates.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		updates, err := client.GetFriendUpdates(ctx, FriendUpdatesFilter{
+			Type:       "reviews",
+			Filter:     "top_friends",
+			MaxUpdates: 4,
+		})
+
+		jonathan := User{ID: 3, Name: "Jonathan"}
+		elizabeth := User{ID: 2, Name: "Elizabeth"}
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Update{
+			{
+				Type:       UpdateReview,
+				ActionText: `gave 5 stars to <a href="https://www.goodreads.com/book/show/30841984">Kings of the Wyld</a>`,
+				Link:       "https://www.goodreads.com/review/show/3309241775",
+				ImageURL:   "https://images.gr-assets.com/users/1/3.jpg",
+				Actor:      jonathan,
+				UpdatedAt:  "Wed, 13 May 2020 05:01:43 -0700",
+				Review: &Review{
+					ID:     3309241775,
+					Rating: 5,
+					Body:   "loved it",
+					Book: Book{
+						ID:    30841984,
+						Title: "Kings of the Wyld (The Band, #1)",
+					},
+				},
+			},
+			{
+				Type:       UpdateUserStatus,
+				ActionText: "is on page 120 of 502 of Kings of the Wyld",
+				Link:       "https://www.goodreads.com/user_status/show/306522317",
+				ImageURL:   "https://images.gr-assets.com/users/1/3.jpg",
+				Actor:      jonathan,
+				UpdatedAt:  "Sun, 10 May 2020 20:41:09 -0700",
+				UserStatus: &UserStatus{
+					ID:   306522317,
+					Page: 120,
+					Book: Book{ID: 30841984},
+				},
+			},
+			{
+				Type:       UpdateReadStatus,
+				ActionText: "wants to read Bloody Rose",
+				Link:       "https://www.goodreads.com/read_statuses/2874910224",
+				ImageURL:   "https://images.gr-assets.com/users/1/2.jpg",
+				Actor:      elizabeth,
+				UpdatedAt:  "Sat, 09 May 2020 10:00:00 -0700",
+				ReadStatus: &ReadStatus{
+					ID:     2874910224,
+					Status: "to-read",
+					Book:   Book{ID: 35052265},
+				},
+			},
+			{
+				Type:       UpdateFollow,
+				ActionText: "is now following Otis Chandler",
+				Link:       "https://www.goodreads.com/user/show/1-otis-chandler",
+				ImageURL:   "https://images.gr-assets.com/users/1/2.jpg",
+				Actor:      elizabeth,
+				UpdatedAt:  "Fri, 08 May 2020 08:30:00 -0700",
+				Followed: &User{
+					ID:   1,
+					Name: "Otis Chandler",
+				},
+			},
+		}, updates)
+	})
+
+	t.Run("does not send the empty filters", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.NotContains(t, r.URL.Query(), "update")
+			assert.NotContains(t, r.URL.Query(), "update_filter")
+			assert.NotContains(t, r.URL.Query(), "max_updates")
+
+			content, _ := ioutil.ReadFile("fixtures/get_friend_updates.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		_, err := client.GetFriendUpdates(ctx, FriendUpdatesFilter{})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		updates, err := client.GetFriendUpdates(ctx, FriendUpdatesFilter{})
+
+		assert.EqualError(t, err, "failed to get the friend updates: request failed for '//updates/friends': 401 Unauthorized")
+		assert.Equal(t, []Update{}, updates)
+	})
+}
+
+func TestClient_GetNotifications(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the notifications as updates", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//notifications", r.URL.Path)
+			assert.Equal(t, "1", r.URL.Query().Get("page"))
+
+			content, _ := ioutil.ReadFile("fixtures/get_notifications.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		updates, err := client.GetNotifications(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Update{
+			{
+				Type:       UpdateUserStatus,
+				ActionText: "Jonathan is on page 120",
+				Link:       "https://www.goodreads.com/user_status/show/306522317",
+				ImageURL:   "https://images.gr-assets.com/users/1/3.jpg",
+				Actor: User{
+					ID:       3,
+					Name:     "Jonathan",
+					ImageURL: "https://images.gr-assets.com/users/1/3.jpg",
+				},
+				UpdatedAt: "2020-05-10T20:41:09-07:00",
+				New:       true,
+				UserStatus: &UserStatus{
+					ID:   306522317,
+					Page: 120,
+				},
+			},
+			{
+				Type:       UpdateFollow,
+				ActionText: "Elizabeth is now following you",
+				Link:       "https://www.goodreads.com/user/show/2-elizabeth",
+				ImageURL:   "https://images.gr-assets.com/users/1/2.jpg",
+				Actor: User{
+					ID:       2,
+					Name:     "Elizabeth",
+					ImageURL: "https://images.gr-assets.com/users/1/2.jpg",
+				},
+				UpdatedAt: "2020-05-08T08:30:00-07:00",
+				Followed: &User{
+					ID:   1,
+					Name: "Otis Chandler",
+				},
+			},
+		}, updates)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		updates, err := client.GetNotifications(ctx, 1)
+
+		assert.EqualError(t, err, "failed to get the notifications in page #1: request failed for '//notifications': 401 Unauthorized")
+		assert.Equal(t, []Update{}, updates)
+	})
+}
@@ -0,0 +1,103 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type getUserResponse struct {
+	User userProfile `xml:"user"`
+}
+
+type userProfile struct {
+	User
+	// goodreads calls it favorite books but it's a free text list of genres
+	FavoriteGenres string `xml:"favorite_books"`
+}
+
+type compareBooksResponse struct {
+	Comparison Comparison `xml:"compare"`
+}
+
+// GetUser returns the profile of a member, by id or by username
+func (c client) GetUser(ctx context.Context, idOrUsername string) (User, error) {
+	var response = getUserResponse{}
+
+	q := url.Values{}
+	endpoint := "/user/show"
+
+	if userID, err := strconv.Atoi(idOrUsername); err == nil {
+		endpoint = fmt.Sprintf("/user/show/%d", userID)
+	} else {
+		q.Set("username", idOrUsername)
+	}
+
+	err := c.Get(ctx, endpoint, q, &response)
+
+	if err != nil {
+		return User{}, fmt.Errorf("failed to get the user '%s': %w", idOrUsername, err)
+	}
+
+	user := response.User.User
+	user.FavoriteGenres = splitList(response.User.FavoriteGenres)
+
+	return user, nil
+}
+
+// CompareBooks compares the books of the authenticated user with the ones of the given user
+// The compatibility is computed from the books both rated, 1 being the exact same ratings
+func (c client) CompareBooks(ctx context.Context, otherUserID Int) (Comparison, error) {
+	var response = compareBooksResponse{
+		Comparison{
+			Books: []ComparedBook{},
+		},
+	}
+
+	err := c.Get(ctx, fmt.Sprintf("/user/compare/%d", otherUserID), url.Values{}, &response)
+
+	if err != nil {
+		return Comparison{}, fmt.Errorf("failed to compare books with the user #%d: %w", otherUserID, err)
+	}
+
+	response.Comparison.Compatibility = compatibility(response.Comparison.Books)
+
+	return response.Comparison, nil
+}
+
+// compatibility is 1 minus the average distance between both ratings, ratings go from 1 to 5
+// so the maximum distance is 4, books not rated by one of the users are ignored
+func compatibility(books []ComparedBook) float64 {
+	var distance float64
+	var rated int
+
+	for _, book := range books {
+		if book.YourRating == 0 || book.TheirRating == 0 {
+			continue
+		}
+
+		distance += math.Abs(float64(book.YourRating - book.TheirRating))
+		rated++
+	}
+
+	if rated == 0 {
+		return 0
+	}
+
+	return 1 - distance/float64(rated)/4
+}
+
+func splitList(list string) []string {
+	values := []string{}
+
+	for _, value := range strings.Split(list, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,138 @@
+package goodreads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ReadingProgress where the user is in the book, exactly one of Page or Percent must be set
+// see AtPage and AtPercent
+type ReadingProgress struct {
+	Page    *int
+	Percent *int
+}
+
+// AtPage the user is at the given page
+func AtPage(page int) ReadingProgress {
+	return ReadingProgress{Page: &page}
+}
+
+// AtPercent the user read the given percent of the book
+func AtPercent(percent int) ReadingProgress {
+	return ReadingProgress{Percent: &percent}
+}
+
+// validate checks exactly one of the page or the percent is set, with a possible value
+func (p ReadingProgress) validate() error {
+	switch {
+	case p.Page == nil && p.Percent == nil:
+		return errors.New("invalid reading progress: set the page or the percent")
+	case p.Page != nil && p.Percent != nil:
+		return errors.New("invalid reading progress: set the page or the percent, not both")
+	case p.Page != nil && *p.Page < 0:
+		return fmt.Errorf("invalid reading progress: page %d", *p.Page)
+	case p.Percent != nil && (*p.Percent < 0 || *p.Percent > 100):
+		return fmt.Errorf("invalid reading progress: percent %d out of range", *p.Percent)
+	}
+
+	return nil
+}
+
+type userStatusResponse struct {
+	UserStatus UserStatus `xml:"user_status"`
+}
+
+type listUserStatusesResponse struct {
+	UserStatuses []UserStatus `xml:"user_statuses>user_status"`
+}
+
+type getReadStatusResponse struct {
+	ReadStatus ReadStatus `xml:"read_status"`
+}
+
+// CreateUserStatus updates the progress of the authenticated user in the book
+func (c client) CreateUserStatus(ctx context.Context, bookID Int, progress ReadingProgress, body string) (UserStatus, error) {
+	var response = userStatusResponse{}
+
+	if err := progress.validate(); err != nil {
+		return UserStatus{}, fmt.Errorf("failed to update the status for the book #%d: %w", bookID, err)
+	}
+
+	form := url.Values{}
+	form.Set("user_status[book_id]", strconv.Itoa(int(bookID)))
+
+	if progress.Page != nil {
+		form.Set("user_status[page]", strconv.Itoa(*progress.Page))
+	}
+
+	if progress.Percent != nil {
+		form.Set("user_status[percent]", strconv.Itoa(*progress.Percent))
+	}
+
+	if body != "" {
+		form.Set("user_status[body]", body)
+	}
+
+	err := c.Post(ctx, "/user_status", form, &response)
+
+	if err != nil {
+		return UserStatus{}, fmt.Errorf("failed to update the status for the book #%d: %w", bookID, err)
+	}
+
+	return response.UserStatus, nil
+}
+
+// DeleteUserStatus removes one of the statuses of the authenticated user
+func (c client) DeleteUserStatus(ctx context.Context, statusID Int) error {
+	err := c.Post(ctx, fmt.Sprintf("/user_status/destroy/%d", statusID), url.Values{}, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete the user status #%d: %w", statusID, err)
+	}
+
+	return nil
+}
+
+// GetUserStatus retrieve a specific user status
+func (c client) GetUserStatus(ctx context.Context, statusID Int) (UserStatus, error) {
+	var response = userStatusResponse{}
+
+	err := c.Get(ctx, fmt.Sprintf("/user_status/show/%d", statusID), url.Values{}, &response)
+
+	if err != nil {
+		return UserStatus{}, fmt.Errorf("failed to get the user status #%d: %w", statusID, err)
+	}
+
+	return response.UserStatus, nil
+}
+
+// ListUserStatuses returns the most recent statuses of all the members
+func (c client) ListUserStatuses(ctx context.Context) ([]UserStatus, error) {
+	var response = listUserStatusesResponse{
+		UserStatuses: []UserStatus{},
+	}
+
+	err := c.Get(ctx, "/user_status/index", url.Values{}, &response)
+
+	if err != nil {
+		return []UserStatus{}, fmt.Errorf("failed to get the user statuses: %w", err)
+	}
+
+	return response.UserStatuses, nil
+}
+
+// GetReadStatus retrieve a specific read status
+func (c client) GetReadStatus(ctx context.Context, readStatusID Int) (ReadStatus, error) {
+	var response = getReadStatusResponse{}
+
+	err := c.Get(ctx, fmt.Sprintf("/read_statuses/%d", readStatusID), url.Values{}, &response)
+
+	if err != nil {
+		return ReadStatus{}, fmt.Errorf("failed to get the read status #%d: %w", readStatusID, err)
+	}
+
+	return response.ReadStatus, nil
+}
@@ -0,0 +1,355 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var castiaStatus = UserStatus{
+	ID:            306522317,
+	Body:          "the battle at Castia!",
+	Page:          120,
+	CommentsCount: 1,
+	LikesCount:    4,
+	CreatedAt:     "2020-05-10T20:41:09-07:00",
+	UpdatedAt:     "2020-05-10T20:41:09-07:00",
+	User: User{
+		ID:   3,
+		Name: "Jonathan",
+	},
+	Book: Book{
+		ID:      30841984,
+		Title:   "Kings of the Wyld (The Band, #1)",
+		NumPage: 502,
+	},
+}
+
+func TestClient_CreateUserStatus(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts the progress and returns the status", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "//user_status", r.URL.Path)
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "30841984", r.PostForm.Get("user_status[book_id]"))
+			assert.Equal(t, "120", r.PostForm.Get("user_status[page]"))
+			assert.NotContains(t, r.PostForm, "user_status[percent]")
+			assert.Equal(t, "the battle at Castia!", r.PostForm.Get("user_status[body]"))
+
+			content, _ := ioutil.ReadFile("fixtures/get_user_status.xml")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		status, err := client.CreateUserStatus(ctx, 30841984, AtPage(120), "the battle at Castia!")
+
+		assert.NoError(t, err)
+		assert.Equal(t, castiaStatus, status)
+	})
+
+	t.Run("posts only the percent when given", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "35", r.PostForm.Get("user_status[percent]"))
+			assert.NotContains(t, r.PostForm, "user_status[page]")
+			assert.NotContains(t, r.PostForm, "user_status[body]")
+
+			content, _ := ioutil.ReadFile("fixtures/get_user_status.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		_, err := client.CreateUserStatus(ctx, 30841984, AtPercent(35), "")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("posts a progress of 0", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "0", r.PostForm.Get("user_status[percent]"))
+			assert.NotContains(t, r.PostForm, "user_status[page]")
+
+			content, _ := ioutil.ReadFile("fixtures/get_user_status.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		_, err := client.CreateUserStatus(ctx, 30841984, AtPercent(0), "")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns an error without calling goodreads if the progress is invalid", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("goodreads should not be called")
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		page, percent := 12, 30
+
+		tests := []struct {
+			progress ReadingProgress
+			expected string
+		}{
+			{ReadingProgress{}, "invalid reading progress: set the page or the percent"},
+			{ReadingProgress{Page: &page, Percent: &percent}, "invalid reading progress: set the page or the percent, not both"},
+			{AtPage(-1), "invalid reading progress: page -1"},
+			{AtPercent(101), "invalid reading progress: percent 101 out of range"},
+		}
+
+		for _, tt := range tests {
+			status, err := client.CreateUserStatus(ctx, 30841984, tt.progress, "")
+
+			assert.EqualError(t, err, "failed to update the status for the book #30841984: "+tt.expected)
+			assert.Equal(t, UserStatus{}, status)
+		}
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		status, err := client.CreateUserStatus(ctx, 30841984, AtPage(120), "")
+
+		assert.EqualError(t, err, "failed to update the status for the book #30841984: request failed for '//user_status': 401 Unauthorized")
+		assert.Equal(t, UserStatus{}, status)
+	})
+}
+
+func TestClient_DeleteUserStatus(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("posts to the destroy endpoint", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "//user_status/destroy/306522317", r.URL.Path)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		assert.NoError(t, client.DeleteUserStatus(ctx, 306522317))
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		err := client.DeleteUserStatus(ctx, 306522317)
+
+		assert.EqualError(t, err, "failed to delete the user status #306522317: request failed for '//user_status/destroy/306522317': 404 Not Found")
+	})
+}
+
+func TestClient_GetUserStatus(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the status", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//user_status/show/306522317", r.URL.Path)
+
+			content, _ := ioutil.ReadFile("fixtures/get_user_status.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		status, err := client.GetUserStatus(ctx, 306522317)
+
+		assert.NoError(t, err)
+		assert.Equal(t, castiaStatus, status)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		status, err := client.GetUserStatus(ctx, 306522317)
+
+		assert.EqualError(t, err, "failed to get the user status #306522317: request failed for '//user_status/show/306522317': 404 Not Found")
+		assert.Equal(t, UserStatus{}, status)
+	})
+}
+
+func TestClient_ListUserStatuses(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the recent statuses", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//user_status/index", r.URL.Path)
+
+			content, _ := ioutil.ReadFile("fixtures/list_user_statuses.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		statuses, err := client.ListUserStatuses(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []UserStatus{
+			castiaStatus,
+			{
+				ID:        306522318,
+				Percent:   35,
+				CreatedAt: "2020-05-10T20:40:01-07:00",
+				UpdatedAt: "2020-05-10T20:40:01-07:00",
+				User: User{
+					ID:   2,
+					Name: "Elizabeth",
+				},
+				Book: Book{
+					ID:      35052265,
+					Title:   "Bloody Rose (The Band, #2)",
+					NumPage: 544,
+				},
+			},
+		}, statuses)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		statuses, err := client.ListUserStatuses(ctx)
+
+		assert.EqualError(t, err, "failed to get the user statuses: request failed for '//user_status/index': 500 Internal Server Error")
+		assert.Equal(t, []UserStatus{}, statuses)
+	})
+}
+
+func TestClient_GetReadStatus(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the read status with its book", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//read_statuses/2874910223", r.URL.Path)
+
+			content, _ := ioutil.ReadFile("fixtures/get_read_status.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		status, err := client.GetReadStatus(ctx, 2874910223)
+
+		assert.NoError(t, err)
+		assert.Equal(t, ReadStatus{
+			ID:        2874910223,
+			Status:    "read",
+			OldStatus: "currently-reading",
+			UpdatedAt: "2020-05-12T22:01:43-07:00",
+			User: User{
+				ID:   3,
+				Name: "Jonathan",
+			},
+			Book: Book{
+				ID:      30841984,
+				Title:   "Kings of the Wyld (The Band, #1)",
+				NumPage: 502,
+			},
+		}, status)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		status, err := client.GetReadStatus(ctx, 2874910223)
+
+		assert.EqualError(t, err, "failed to get the read status #2874910223: request failed for '//read_statuses/2874910223': 404 Not Found")
+		assert.Equal(t, ReadStatus{}, status)
+	})
+}
@@ -0,0 +1,204 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetUser(t *testing.T) {
+	var ctx = context.TODO()
+
+	var otis = User{
+		ID:            1,
+		Name:          "Otis Chandler",
+		UserName:      "otis",
+		Link:          "https://www.goodreads.com/user/show/1-otis-chandler",
+		ImageURL:      "https://images.gr-assets.com/users/1189644957p3/1.jpg",
+		SmallImageURL: "https://images.gr-assets.com/users/1189644957p2/1.jpg",
+		About:         "I'm the founder of goodreads.",
+		Gender:        "male",
+		Location:      "San Francisco, CA",
+		Website:       "http://www.goodreads.com",
+		Joined:        "01/2007",
+		LastActive:    "04/2020",
+		Interests:     "running, startups",
+		FavoriteGenres: []string{
+			"Science Fiction",
+			"Fantasy",
+			"Business",
+		},
+		FavoriteAuthors: []Author{
+			{
+				ID:   38550,
+				Name: "Brandon Sanderson",
+			},
+		},
+		FriendsCount: 1090,
+		GroupsCount:  2,
+		ReviewsCount: 1001,
+		Shelves: []Shelf{
+			{
+				ID:        1,
+				Name:      "read",
+				BookCount: 897,
+				Exclusive: true,
+			},
+			{
+				ID:        2,
+				Name:      "to-read",
+				BookCount: 104,
+				Exclusive: true,
+				Featured:  true,
+			},
+		},
+	}
+
+	t.Run("returns the user by id", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//user/show/1", r.URL.Path)
+			assert.Equal(t, "", r.URL.Query().Get("username"))
+
+			content, _ := ioutil.ReadFile("fixtures/get_user.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		user, err := client.GetUser(ctx, "1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, otis, user)
+	})
+
+	t.Run("returns the user by username", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//user/show", r.URL.Path)
+			assert.Equal(t, "otis", r.URL.Query().Get("username"))
+
+			content, _ := ioutil.ReadFile("fixtures/get_user.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		user, err := client.GetUser(ctx, "otis")
+
+		assert.NoError(t, err)
+		assert.Equal(t, otis, user)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "wtf", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		user, err := client.GetUser(ctx, "otis")
+
+		assert.EqualError(t, err, "failed to get the user 'otis': request failed for '//user/show': 500 Internal Server Error")
+		assert.Equal(t, User{}, user)
+	})
+}
+
+func TestClient_CompareBooks(t *testing.T) {
+	var ctx = context.TODO()
+
+	t.Run("returns the comparison with the compatibility", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "//user/compare/42", r.URL.Path)
+
+			content, _ := ioutil.ReadFile("fixtures/compare_books.xml")
+			_, _ = fmt.Fprintln(w, string(content))
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		comparison, err := client.CompareBooks(ctx, 42)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Comparison{
+			YourLibraryPercent:   12.5,
+			TheirLibraryPercent:  1.2,
+			YourTotalBooksCount:  24,
+			TheirTotalBooksCount: 250,
+			CommonCount:          3,
+			Books: []ComparedBook{
+				{
+					Book:        Book{ID: 30841984, Title: "Kings of the Wyld (The Band, #1)", Link: "https://www.goodreads.com/book/show/30841984-kings-of-the-wyld"},
+					YourRating:  5,
+					TheirRating: 4,
+				},
+				{
+					Book:        Book{ID: 35052265, Title: "Bloody Rose (The Band, #2)", Link: "https://www.goodreads.com/book/show/35052265-bloody-rose"},
+					YourRating:  4,
+					TheirRating: 2,
+				},
+				{
+					Book:        Book{ID: 31932963, Title: "Outlaw Empire (The Band, #3)", Link: "https://www.goodreads.com/book/show/31932963-outlaw-empire"},
+					YourRating:  0,
+					TheirRating: 3,
+				},
+			},
+			Compatibility: 0.625,
+		}, comparison)
+	})
+
+	t.Run("returns an error if the call failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		client := client{
+			APIKey: "123",
+			domain: ts.URL,
+			http:   ts.Client(),
+		}
+
+		comparison, err := client.CompareBooks(ctx, 42)
+
+		assert.EqualError(t, err, "failed to compare books with the user #42: request failed for '//user/compare/42': 401 Unauthorized")
+		assert.Equal(t, Comparison{}, comparison)
+	})
+}
+
+func TestCompatibility(t *testing.T) {
+	t.Run("it is 1 when both users rated the same", func(t *testing.T) {
+		assert.Equal(t, 1.0, compatibility([]ComparedBook{{YourRating: 3, TheirRating: 3}}))
+	})
+
+	t.Run("it is 0 when the ratings are opposite", func(t *testing.T) {
+		assert.Equal(t, 0.0, compatibility([]ComparedBook{{YourRating: 1, TheirRating: 5}}))
+	})
+
+	t.Run("it is 0 when no book is rated by both", func(t *testing.T) {
+		assert.Equal(t, 0.0, compatibility([]ComparedBook{{YourRating: 0, TheirRating: 5}}))
+	})
+}
@@ -0,0 +1,37 @@
+package goodreads
+
+import (
+	"encoding/xml"
+	"reflect"
+)
+
+// work has the same fields as Work without the custom unmarshaler
+type work Work
+
+// UnmarshalXML decodes the work, the original publication date is split in 3 elements
+// A date with a part which is not a number is left to zero, strict mode reports it
+func (w *Work) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var raw struct {
+		work
+		OriginalPublicationYear  string `xml:"original_publication_year"`
+		OriginalPublicationMonth string `xml:"original_publication_month"`
+		OriginalPublicationDay   string `xml:"original_publication_day"`
+	}
+
+	if err := d.DecodeElement(&raw, &start); err != nil {
+		return err
+	}
+
+	*w = Work(raw.work)
+	w.OriginalPublicationDate, _ = newPartialDate(raw.OriginalPublicationYear, raw.OriginalPublicationMonth, raw.OriginalPublicationDay)
+
+	return nil
+}
+
+func (Work) handDecodedElements() map[string]reflect.Type {
+	return map[string]reflect.Type{
+		"original_publication_year":  reflect.TypeOf(Int(0)),
+		"original_publication_month": reflect.TypeOf(Int(0)),
+		"original_publication_day":   reflect.TypeOf(Int(0)),
+	}
+}
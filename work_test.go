@@ -0,0 +1,79 @@
+package goodreads
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWork_UnmarshalXML(t *testing.T) {
+	t.Run("decodes the partial original publication date", func(t *testing.T) {
+		var work Work
+
+		err := xml.Unmarshal([]byte(`<work>
+			<id>1</id>
+			<original_publication_year type="integer">2017</original_publication_year>
+			<original_publication_month type="integer" nil="true"/>
+			<original_publication_day type="integer"> </original_publication_day>
+		</work>`), &work)
+
+		assert.NoError(t, err)
+
+		work.Raw = nil
+
+		assert.Equal(t, Work{WorkID: 1, OriginalPublicationDate: PartialDate{Year: 2017}}, work)
+	})
+
+	t.Run("leaves the empty counts to zero and tells the null values apart", func(t *testing.T) {
+		var work Work
+
+		err := xml.Unmarshal([]byte(`<work>
+			<id>1</id>
+			<books_count type="integer"><![CDATA[ ]]></books_count>
+			<ratings_count type="integer">0</ratings_count>
+			<text_reviews_count type="integer"> 12 </text_reviews_count>
+			<original_language_id type="integer" nil="true"/>
+			<default_chaptering_book_id type="integer">0</default_chaptering_book_id>
+		</work>`), &work)
+
+		assert.NoError(t, err)
+
+		work.Raw = nil
+
+		assert.Equal(t, Work{
+			WorkID:                  1,
+			TextReviewsCount:        12,
+			OriginalLanguageID:      NullInt{Present: true},
+			DefaultChapteringBookID: NullInt{Int: 0, Valid: true, Present: true},
+		}, work)
+		assert.True(t, work.OriginalLanguageID.IsNull())
+		assert.False(t, work.DefaultChapteringBookID.IsNull())
+	})
+
+	t.Run("returns an error for a malformed count", func(t *testing.T) {
+		var work Work
+
+		err := xml.Unmarshal([]byte("<work><ratings_sum>lots</ratings_sum></work>"), &work)
+
+		assert.EqualError(t, err, "strconv.Atoi: parsing \"lots\": invalid syntax")
+	})
+
+	t.Run("decodes the years before Christ", func(t *testing.T) {
+		var work Work
+
+		assert.NoError(t, xml.Unmarshal([]byte("<work><id>1</id><original_publication_year>-700</original_publication_year></work>"), &work))
+		assert.Equal(t, PartialDate{Year: -700}, work.OriginalPublicationDate)
+		assert.Equal(t, "-0700", work.OriginalPublicationDate.String())
+	})
+
+	t.Run("leaves a malformed date to zero", func(t *testing.T) {
+		var work Work
+
+		err := xml.Unmarshal([]byte("<work><id>1</id><original_publication_year>MMXVII</original_publication_year><original_publication_month>3</original_publication_month></work>"), &work)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Int(1), work.WorkID)
+		assert.True(t, work.OriginalPublicationDate.IsZero())
+	})
+}